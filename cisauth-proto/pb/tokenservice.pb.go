@@ -25,9 +25,11 @@ type UserProfile struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID    string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name  string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Email string `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	ID          string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Email       string   `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Roles       []string `protobuf:"bytes,4,rep,name=Roles,proto3" json:"Roles,omitempty"`
+	Permissions []string `protobuf:"bytes,5,rep,name=Permissions,proto3" json:"Permissions,omitempty"`
 }
 
 func (x *UserProfile) Reset() {
@@ -83,6 +85,20 @@ func (x *UserProfile) GetEmail() string {
 	return ""
 }
 
+func (x *UserProfile) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UserProfile) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type AcceptLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -90,6 +106,9 @@ type AcceptLoginRequest struct {
 
 	LoginChallenge string       `protobuf:"bytes,1,opt,name=LoginChallenge,proto3" json:"LoginChallenge,omitempty"`
 	UserProfile    *UserProfile `protobuf:"bytes,2,opt,name=UserProfile,proto3" json:"UserProfile,omitempty"`
+	Acr            string       `protobuf:"bytes,3,opt,name=Acr,proto3" json:"Acr,omitempty"`
+	Amr            []string     `protobuf:"bytes,4,rep,name=Amr,proto3" json:"Amr,omitempty"`
+	Remember       bool         `protobuf:"varint,5,opt,name=Remember,proto3" json:"Remember,omitempty"`
 }
 
 func (x *AcceptLoginRequest) Reset() {
@@ -138,6 +157,27 @@ func (x *AcceptLoginRequest) GetUserProfile() *UserProfile {
 	return nil
 }
 
+func (x *AcceptLoginRequest) GetAcr() string {
+	if x != nil {
+		return x.Acr
+	}
+	return ""
+}
+
+func (x *AcceptLoginRequest) GetAmr() []string {
+	if x != nil {
+		return x.Amr
+	}
+	return nil
+}
+
+func (x *AcceptLoginRequest) GetRemember() bool {
+	if x != nil {
+		return x.Remember
+	}
+	return false
+}
+
 type AcceptLoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -185,16 +225,16 @@ func (x *AcceptLoginResponse) GetRedirectTo() string {
 	return ""
 }
 
-type AcceptConsentRequest struct {
+type GetLoginRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConsentChallenge string `protobuf:"bytes,1,opt,name=ConsentChallenge,proto3" json:"ConsentChallenge,omitempty"`
+	LoginChallenge string `protobuf:"bytes,1,opt,name=LoginChallenge,proto3" json:"LoginChallenge,omitempty"`
 }
 
-func (x *AcceptConsentRequest) Reset() {
-	*x = AcceptConsentRequest{}
+func (x *GetLoginRequestRequest) Reset() {
+	*x = GetLoginRequestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -202,13 +242,13 @@ func (x *AcceptConsentRequest) Reset() {
 	}
 }
 
-func (x *AcceptConsentRequest) String() string {
+func (x *GetLoginRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptConsentRequest) ProtoMessage() {}
+func (*GetLoginRequestRequest) ProtoMessage() {}
 
-func (x *AcceptConsentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLoginRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -220,28 +260,34 @@ func (x *AcceptConsentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptConsentRequest.ProtoReflect.Descriptor instead.
-func (*AcceptConsentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoginRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginRequestRequest) Descriptor() ([]byte, []int) {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AcceptConsentRequest) GetConsentChallenge() string {
+func (x *GetLoginRequestRequest) GetLoginChallenge() string {
 	if x != nil {
-		return x.ConsentChallenge
+		return x.LoginChallenge
 	}
 	return ""
 }
 
-type AcceptConsentResponse struct {
+type GetLoginRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RedirectTo string `protobuf:"bytes,1,opt,name=RedirectTo,proto3" json:"RedirectTo,omitempty"`
+	Skip           bool     `protobuf:"varint,1,opt,name=Skip,proto3" json:"Skip,omitempty"`
+	Subject        string   `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	ClientID       string   `protobuf:"bytes,3,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	ClientName     string   `protobuf:"bytes,4,opt,name=ClientName,proto3" json:"ClientName,omitempty"`
+	LogoURI        string   `protobuf:"bytes,5,opt,name=LogoURI,proto3" json:"LogoURI,omitempty"`
+	RequestedScope []string `protobuf:"bytes,6,rep,name=RequestedScope,proto3" json:"RequestedScope,omitempty"`
+	LoginHint      string   `protobuf:"bytes,7,opt,name=LoginHint,proto3" json:"LoginHint,omitempty"`
 }
 
-func (x *AcceptConsentResponse) Reset() {
-	*x = AcceptConsentResponse{}
+func (x *GetLoginRequestResponse) Reset() {
+	*x = GetLoginRequestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,13 +295,13 @@ func (x *AcceptConsentResponse) Reset() {
 	}
 }
 
-func (x *AcceptConsentResponse) String() string {
+func (x *GetLoginRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptConsentResponse) ProtoMessage() {}
+func (*GetLoginRequestResponse) ProtoMessage() {}
 
-func (x *AcceptConsentResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLoginRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -267,31 +313,73 @@ func (x *AcceptConsentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptConsentResponse.ProtoReflect.Descriptor instead.
-func (*AcceptConsentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoginRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginRequestResponse) Descriptor() ([]byte, []int) {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AcceptConsentResponse) GetRedirectTo() string {
+func (x *GetLoginRequestResponse) GetSkip() bool {
 	if x != nil {
-		return x.RedirectTo
+		return x.Skip
+	}
+	return false
+}
+
+func (x *GetLoginRequestResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
 	}
 	return ""
 }
 
-type TokenExchangeRequest struct {
+func (x *GetLoginRequestResponse) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *GetLoginRequestResponse) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *GetLoginRequestResponse) GetLogoURI() string {
+	if x != nil {
+		return x.LogoURI
+	}
+	return ""
+}
+
+func (x *GetLoginRequestResponse) GetRequestedScope() []string {
+	if x != nil {
+		return x.RequestedScope
+	}
+	return nil
+}
+
+func (x *GetLoginRequestResponse) GetLoginHint() string {
+	if x != nil {
+		return x.LoginHint
+	}
+	return ""
+}
+
+type AcceptConsentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code         string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	RedirectURI  string `protobuf:"bytes,2,opt,name=RedirectURI,proto3" json:"RedirectURI,omitempty"`
-	ClientID     string `protobuf:"bytes,3,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
-	CodeVerifier string `protobuf:"bytes,4,opt,name=CodeVerifier,proto3" json:"CodeVerifier,omitempty"`
+	ConsentChallenge string   `protobuf:"bytes,1,opt,name=ConsentChallenge,proto3" json:"ConsentChallenge,omitempty"`
+	GrantScope       []string `protobuf:"bytes,2,rep,name=GrantScope,proto3" json:"GrantScope,omitempty"`
+	GrantAudience    []string `protobuf:"bytes,3,rep,name=GrantAudience,proto3" json:"GrantAudience,omitempty"`
+	Remember         bool     `protobuf:"varint,4,opt,name=Remember,proto3" json:"Remember,omitempty"`
 }
 
-func (x *TokenExchangeRequest) Reset() {
-	*x = TokenExchangeRequest{}
+func (x *AcceptConsentRequest) Reset() {
+	*x = AcceptConsentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -299,13 +387,13 @@ func (x *TokenExchangeRequest) Reset() {
 	}
 }
 
-func (x *TokenExchangeRequest) String() string {
+func (x *AcceptConsentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenExchangeRequest) ProtoMessage() {}
+func (*AcceptConsentRequest) ProtoMessage() {}
 
-func (x *TokenExchangeRequest) ProtoReflect() protoreflect.Message {
+func (x *AcceptConsentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -317,54 +405,49 @@ func (x *TokenExchangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenExchangeRequest.ProtoReflect.Descriptor instead.
-func (*TokenExchangeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptConsentRequest.ProtoReflect.Descriptor instead.
+func (*AcceptConsentRequest) Descriptor() ([]byte, []int) {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TokenExchangeRequest) GetCode() string {
+func (x *AcceptConsentRequest) GetConsentChallenge() string {
 	if x != nil {
-		return x.Code
+		return x.ConsentChallenge
 	}
 	return ""
 }
 
-func (x *TokenExchangeRequest) GetRedirectURI() string {
+func (x *AcceptConsentRequest) GetGrantScope() []string {
 	if x != nil {
-		return x.RedirectURI
+		return x.GrantScope
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenExchangeRequest) GetClientID() string {
+func (x *AcceptConsentRequest) GetGrantAudience() []string {
 	if x != nil {
-		return x.ClientID
+		return x.GrantAudience
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenExchangeRequest) GetCodeVerifier() string {
+func (x *AcceptConsentRequest) GetRemember() bool {
 	if x != nil {
-		return x.CodeVerifier
+		return x.Remember
 	}
-	return ""
+	return false
 }
 
-type TokenExchangeResponse struct {
+type AcceptConsentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken  string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
-	IDToken      string `protobuf:"bytes,2,opt,name=IDToken,proto3" json:"IDToken,omitempty"`
-	RefreshToken string `protobuf:"bytes,3,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"`
-	ExpiresIn    int64  `protobuf:"varint,4,opt,name=ExpiresIn,proto3" json:"ExpiresIn,omitempty"`
-	ExpiresAt    string `protobuf:"bytes,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
-	SessionID    string `protobuf:"bytes,6,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	RedirectTo string `protobuf:"bytes,1,opt,name=RedirectTo,proto3" json:"RedirectTo,omitempty"`
 }
 
-func (x *TokenExchangeResponse) Reset() {
-	*x = TokenExchangeResponse{}
+func (x *AcceptConsentResponse) Reset() {
+	*x = AcceptConsentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -372,13 +455,13 @@ func (x *TokenExchangeResponse) Reset() {
 	}
 }
 
-func (x *TokenExchangeResponse) String() string {
+func (x *AcceptConsentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenExchangeResponse) ProtoMessage() {}
+func (*AcceptConsentResponse) ProtoMessage() {}
 
-func (x *TokenExchangeResponse) ProtoReflect() protoreflect.Message {
+func (x *AcceptConsentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -390,64 +473,28 @@ func (x *TokenExchangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenExchangeResponse.ProtoReflect.Descriptor instead.
-func (*TokenExchangeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptConsentResponse.ProtoReflect.Descriptor instead.
+func (*AcceptConsentResponse) Descriptor() ([]byte, []int) {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TokenExchangeResponse) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *TokenExchangeResponse) GetIDToken() string {
-	if x != nil {
-		return x.IDToken
-	}
-	return ""
-}
-
-func (x *TokenExchangeResponse) GetRefreshToken() string {
-	if x != nil {
-		return x.RefreshToken
-	}
-	return ""
-}
-
-func (x *TokenExchangeResponse) GetExpiresIn() int64 {
-	if x != nil {
-		return x.ExpiresIn
-	}
-	return 0
-}
-
-func (x *TokenExchangeResponse) GetExpiresAt() string {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return ""
-}
-
-func (x *TokenExchangeResponse) GetSessionID() string {
+func (x *AcceptConsentResponse) GetRedirectTo() string {
 	if x != nil {
-		return x.SessionID
+		return x.RedirectTo
 	}
 	return ""
 }
 
-type IntrospectRequest struct {
+type GetConsentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
-	SessionID   string `protobuf:"bytes,2,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	ConsentChallenge string `protobuf:"bytes,1,opt,name=ConsentChallenge,proto3" json:"ConsentChallenge,omitempty"`
 }
 
-func (x *IntrospectRequest) Reset() {
-	*x = IntrospectRequest{}
+func (x *GetConsentRequest) Reset() {
+	*x = GetConsentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -455,13 +502,13 @@ func (x *IntrospectRequest) Reset() {
 	}
 }
 
-func (x *IntrospectRequest) String() string {
+func (x *GetConsentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntrospectRequest) ProtoMessage() {}
+func (*GetConsentRequest) ProtoMessage() {}
 
-func (x *IntrospectRequest) ProtoReflect() protoreflect.Message {
+func (x *GetConsentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -473,37 +520,34 @@ func (x *IntrospectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntrospectRequest.ProtoReflect.Descriptor instead.
-func (*IntrospectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConsentRequest.ProtoReflect.Descriptor instead.
+func (*GetConsentRequest) Descriptor() ([]byte, []int) {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *IntrospectRequest) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *IntrospectRequest) GetSessionID() string {
+func (x *GetConsentRequest) GetConsentChallenge() string {
 	if x != nil {
-		return x.SessionID
+		return x.ConsentChallenge
 	}
 	return ""
 }
 
-type IDToken struct {
+type GetConsentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserProfile     *UserProfile `protobuf:"bytes,1,opt,name=UserProfile,proto3" json:"UserProfile,omitempty"`
-	Subject         string       `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`
-	AccessTokenHash string       `protobuf:"bytes,3,opt,name=AccessTokenHash,proto3" json:"AccessTokenHash,omitempty"`
+	ClientID          string   `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	ClientName        string   `protobuf:"bytes,2,opt,name=ClientName,proto3" json:"ClientName,omitempty"`
+	LogoURI           string   `protobuf:"bytes,3,opt,name=LogoURI,proto3" json:"LogoURI,omitempty"`
+	RequestedScope    []string `protobuf:"bytes,4,rep,name=RequestedScope,proto3" json:"RequestedScope,omitempty"`
+	RequestedAudience []string `protobuf:"bytes,5,rep,name=RequestedAudience,proto3" json:"RequestedAudience,omitempty"`
+	Skip              bool     `protobuf:"varint,6,opt,name=Skip,proto3" json:"Skip,omitempty"`
+	Trusted           bool     `protobuf:"varint,7,opt,name=Trusted,proto3" json:"Trusted,omitempty"`
 }
 
-func (x *IDToken) Reset() {
-	*x = IDToken{}
+func (x *GetConsentResponse) Reset() {
+	*x = GetConsentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,13 +555,13 @@ func (x *IDToken) Reset() {
 	}
 }
 
-func (x *IDToken) String() string {
+func (x *GetConsentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDToken) ProtoMessage() {}
+func (*GetConsentResponse) ProtoMessage() {}
 
-func (x *IDToken) ProtoReflect() protoreflect.Message {
+func (x *GetConsentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,72 +573,84 @@ func (x *IDToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDToken.ProtoReflect.Descriptor instead.
-func (*IDToken) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConsentResponse.ProtoReflect.Descriptor instead.
+func (*GetConsentResponse) Descriptor() ([]byte, []int) {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *IDToken) GetUserProfile() *UserProfile {
+func (x *GetConsentResponse) GetClientID() string {
 	if x != nil {
-		return x.UserProfile
+		return x.ClientID
 	}
-	return nil
+	return ""
 }
 
-func (x *IDToken) GetSubject() string {
+func (x *GetConsentResponse) GetClientName() string {
 	if x != nil {
-		return x.Subject
+		return x.ClientName
 	}
 	return ""
 }
 
-func (x *IDToken) GetAccessTokenHash() string {
+func (x *GetConsentResponse) GetLogoURI() string {
 	if x != nil {
-		return x.AccessTokenHash
+		return x.LogoURI
 	}
 	return ""
 }
 
-type IntrospectResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetConsentResponse) GetRequestedScope() []string {
+	if x != nil {
+		return x.RequestedScope
+	}
+	return nil
+}
 
-	NewAccessToken         string   `protobuf:"bytes,1,opt,name=NewAccessToken,proto3" json:"NewAccessToken,omitempty"`
-	IsAccessTokenRefreshed bool     `protobuf:"varint,2,opt,name=IsAccessTokenRefreshed,proto3" json:"IsAccessTokenRefreshed,omitempty"`
-	NewAccessTokenExpiry   int64    `protobuf:"varint,3,opt,name=NewAccessTokenExpiry,proto3" json:"NewAccessTokenExpiry,omitempty"`
-	IDToken                *IDToken `protobuf:"bytes,4,opt,name=IDToken,proto3" json:"IDToken,omitempty"`
-	Active                 bool     `protobuf:"varint,5,opt,name=Active,proto3" json:"Active,omitempty"`
-	Audience               []string `protobuf:"bytes,6,rep,name=Audience,proto3" json:"Audience,omitempty"`
-	ClientID               string   `protobuf:"bytes,7,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
-	Expiry                 int64    `protobuf:"varint,8,opt,name=Expiry,proto3" json:"Expiry,omitempty"`
-	IssuedAt               int64    `protobuf:"varint,9,opt,name=IssuedAt,proto3" json:"IssuedAt,omitempty"`
-	Issuer                 string   `protobuf:"bytes,10,opt,name=Issuer,proto3" json:"Issuer,omitempty"`
-	NotBefore              int64    `protobuf:"varint,11,opt,name=NotBefore,proto3" json:"NotBefore,omitempty"`
-	ObfuscatedSubject      string   `protobuf:"bytes,12,opt,name=ObfuscatedSubject,proto3" json:"ObfuscatedSubject,omitempty"`
-	Scope                  string   `protobuf:"bytes,13,opt,name=Scope,proto3" json:"Scope,omitempty"`
-	Subject                string   `protobuf:"bytes,14,opt,name=Subject,proto3" json:"Subject,omitempty"`
-	TokenType              string   `protobuf:"bytes,15,opt,name=TokenType,proto3" json:"TokenType,omitempty"`
-	TokenUse               string   `protobuf:"bytes,16,opt,name=TokenUse,proto3" json:"TokenUse,omitempty"`
-	Username               string   `protobuf:"bytes,17,opt,name=Username,proto3" json:"Username,omitempty"`
+func (x *GetConsentResponse) GetRequestedAudience() []string {
+	if x != nil {
+		return x.RequestedAudience
+	}
+	return nil
 }
 
-func (x *IntrospectResponse) Reset() {
-	*x = IntrospectResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetConsentResponse) GetSkip() bool {
+	if x != nil {
+		return x.Skip
 	}
+	return false
 }
 
-func (x *IntrospectResponse) String() string {
+func (x *GetConsentResponse) GetTrusted() bool {
+	if x != nil {
+		return x.Trusted
+	}
+	return false
+}
+
+type RejectConsentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsentChallenge string `protobuf:"bytes,1,opt,name=ConsentChallenge,proto3" json:"ConsentChallenge,omitempty"`
+}
+
+func (x *RejectConsentRequest) Reset() {
+	*x = RejectConsentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectConsentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntrospectResponse) ProtoMessage() {}
+func (*RejectConsentRequest) ProtoMessage() {}
 
-func (x *IntrospectResponse) ProtoReflect() protoreflect.Message {
+func (x *RejectConsentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,157 +662,190 @@ func (x *IntrospectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntrospectResponse.ProtoReflect.Descriptor instead.
-func (*IntrospectResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectConsentRequest.ProtoReflect.Descriptor instead.
+func (*RejectConsentRequest) Descriptor() ([]byte, []int) {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *IntrospectResponse) GetNewAccessToken() string {
+func (x *RejectConsentRequest) GetConsentChallenge() string {
 	if x != nil {
-		return x.NewAccessToken
+		return x.ConsentChallenge
 	}
 	return ""
 }
 
-func (x *IntrospectResponse) GetIsAccessTokenRefreshed() bool {
-	if x != nil {
-		return x.IsAccessTokenRefreshed
-	}
-	return false
+type RejectConsentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedirectTo string `protobuf:"bytes,1,opt,name=RedirectTo,proto3" json:"RedirectTo,omitempty"`
 }
 
-func (x *IntrospectResponse) GetNewAccessTokenExpiry() int64 {
-	if x != nil {
-		return x.NewAccessTokenExpiry
+func (x *RejectConsentResponse) Reset() {
+	*x = RejectConsentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *IntrospectResponse) GetIDToken() *IDToken {
-	if x != nil {
-		return x.IDToken
-	}
-	return nil
+func (x *RejectConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *IntrospectResponse) GetActive() bool {
-	if x != nil {
-		return x.Active
+func (*RejectConsentResponse) ProtoMessage() {}
+
+func (x *RejectConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *IntrospectResponse) GetAudience() []string {
-	if x != nil {
-		return x.Audience
-	}
-	return nil
+// Deprecated: Use RejectConsentResponse.ProtoReflect.Descriptor instead.
+func (*RejectConsentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *IntrospectResponse) GetClientID() string {
+func (x *RejectConsentResponse) GetRedirectTo() string {
 	if x != nil {
-		return x.ClientID
+		return x.RedirectTo
 	}
 	return ""
 }
 
-func (x *IntrospectResponse) GetExpiry() int64 {
-	if x != nil {
-		return x.Expiry
-	}
-	return 0
+type TokenExchangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code         string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	RedirectURI  string `protobuf:"bytes,2,opt,name=RedirectURI,proto3" json:"RedirectURI,omitempty"`
+	ClientID     string `protobuf:"bytes,3,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	CodeVerifier string `protobuf:"bytes,4,opt,name=CodeVerifier,proto3" json:"CodeVerifier,omitempty"`
+	UserAgent    string `protobuf:"bytes,5,opt,name=UserAgent,proto3" json:"UserAgent,omitempty"`
+	IPAddress    string `protobuf:"bytes,6,opt,name=IPAddress,proto3" json:"IPAddress,omitempty"`
+	Nonce        string `protobuf:"bytes,7,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
 }
 
-func (x *IntrospectResponse) GetIssuedAt() int64 {
-	if x != nil {
-		return x.IssuedAt
+func (x *TokenExchangeRequest) Reset() {
+	*x = TokenExchangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *IntrospectResponse) GetIssuer() string {
-	if x != nil {
-		return x.Issuer
+func (x *TokenExchangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenExchangeRequest) ProtoMessage() {}
+
+func (x *TokenExchangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *IntrospectResponse) GetNotBefore() int64 {
+// Deprecated: Use TokenExchangeRequest.ProtoReflect.Descriptor instead.
+func (*TokenExchangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenExchangeRequest) GetCode() string {
 	if x != nil {
-		return x.NotBefore
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *IntrospectResponse) GetObfuscatedSubject() string {
+func (x *TokenExchangeRequest) GetRedirectURI() string {
 	if x != nil {
-		return x.ObfuscatedSubject
+		return x.RedirectURI
 	}
 	return ""
 }
 
-func (x *IntrospectResponse) GetScope() string {
+func (x *TokenExchangeRequest) GetClientID() string {
 	if x != nil {
-		return x.Scope
+		return x.ClientID
 	}
 	return ""
 }
 
-func (x *IntrospectResponse) GetSubject() string {
+func (x *TokenExchangeRequest) GetCodeVerifier() string {
 	if x != nil {
-		return x.Subject
+		return x.CodeVerifier
 	}
 	return ""
 }
 
-func (x *IntrospectResponse) GetTokenType() string {
+func (x *TokenExchangeRequest) GetUserAgent() string {
 	if x != nil {
-		return x.TokenType
+		return x.UserAgent
 	}
 	return ""
 }
 
-func (x *IntrospectResponse) GetTokenUse() string {
+func (x *TokenExchangeRequest) GetIPAddress() string {
 	if x != nil {
-		return x.TokenUse
+		return x.IPAddress
 	}
 	return ""
 }
 
-func (x *IntrospectResponse) GetUsername() string {
+func (x *TokenExchangeRequest) GetNonce() string {
 	if x != nil {
-		return x.Username
+		return x.Nonce
 	}
 	return ""
 }
 
-type IntrospectVerificationResponse struct {
+type TokenExchangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Active   bool   `protobuf:"varint,1,opt,name=Active,proto3" json:"Active,omitempty"`
-	Email    string `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
-	ClientID string `protobuf:"bytes,3,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	AccessToken  string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	IDToken      string `protobuf:"bytes,2,opt,name=IDToken,proto3" json:"IDToken,omitempty"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"`
+	ExpiresIn    int64  `protobuf:"varint,4,opt,name=ExpiresIn,proto3" json:"ExpiresIn,omitempty"`
+	ExpiresAt    string `protobuf:"bytes,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	SessionID    string `protobuf:"bytes,6,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
 }
 
-func (x *IntrospectVerificationResponse) Reset() {
-	*x = IntrospectVerificationResponse{}
+func (x *TokenExchangeResponse) Reset() {
+	*x = TokenExchangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[10]
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IntrospectVerificationResponse) String() string {
+func (x *TokenExchangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntrospectVerificationResponse) ProtoMessage() {}
+func (*TokenExchangeResponse) ProtoMessage() {}
 
-func (x *IntrospectVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[10]
+func (x *TokenExchangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,57 +856,79 @@ func (x *IntrospectVerificationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntrospectVerificationResponse.ProtoReflect.Descriptor instead.
-func (*IntrospectVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TokenExchangeResponse.ProtoReflect.Descriptor instead.
+func (*TokenExchangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *IntrospectVerificationResponse) GetActive() bool {
+func (x *TokenExchangeResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Active
+		return x.AccessToken
 	}
-	return false
+	return ""
 }
 
-func (x *IntrospectVerificationResponse) GetEmail() string {
+func (x *TokenExchangeResponse) GetIDToken() string {
 	if x != nil {
-		return x.Email
+		return x.IDToken
 	}
 	return ""
 }
 
-func (x *IntrospectVerificationResponse) GetClientID() string {
+func (x *TokenExchangeResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.ClientID
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type IntrospectVerificationRequest struct {
+func (x *TokenExchangeResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *TokenExchangeResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *TokenExchangeResponse) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type IntrospectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	SessionID   string `protobuf:"bytes,2,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
 }
 
-func (x *IntrospectVerificationRequest) Reset() {
-	*x = IntrospectVerificationRequest{}
+func (x *IntrospectRequest) Reset() {
+	*x = IntrospectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[11]
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IntrospectVerificationRequest) String() string {
+func (x *IntrospectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntrospectVerificationRequest) ProtoMessage() {}
+func (*IntrospectRequest) ProtoMessage() {}
 
-func (x *IntrospectVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[11]
+func (x *IntrospectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,44 +939,52 @@ func (x *IntrospectVerificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntrospectVerificationRequest.ProtoReflect.Descriptor instead.
-func (*IntrospectVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use IntrospectRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *IntrospectVerificationRequest) GetAccessToken() string {
+func (x *IntrospectRequest) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-type GenerateVerificationTokenRequest struct {
+func (x *IntrospectRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type IDToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email    string `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
-	ClientID string `protobuf:"bytes,2,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	UserProfile     *UserProfile `protobuf:"bytes,1,opt,name=UserProfile,proto3" json:"UserProfile,omitempty"`
+	Subject         string       `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	AccessTokenHash string       `protobuf:"bytes,3,opt,name=AccessTokenHash,proto3" json:"AccessTokenHash,omitempty"`
 }
 
-func (x *GenerateVerificationTokenRequest) Reset() {
-	*x = GenerateVerificationTokenRequest{}
+func (x *IDToken) Reset() {
+	*x = IDToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[12]
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateVerificationTokenRequest) String() string {
+func (x *IDToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateVerificationTokenRequest) ProtoMessage() {}
+func (*IDToken) ProtoMessage() {}
 
-func (x *GenerateVerificationTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[12]
+func (x *IDToken) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,51 +995,73 @@ func (x *GenerateVerificationTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateVerificationTokenRequest.ProtoReflect.Descriptor instead.
-func (*GenerateVerificationTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use IDToken.ProtoReflect.Descriptor instead.
+func (*IDToken) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GenerateVerificationTokenRequest) GetEmail() string {
+func (x *IDToken) GetUserProfile() *UserProfile {
 	if x != nil {
-		return x.Email
+		return x.UserProfile
+	}
+	return nil
+}
+
+func (x *IDToken) GetSubject() string {
+	if x != nil {
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *GenerateVerificationTokenRequest) GetClientID() string {
+func (x *IDToken) GetAccessTokenHash() string {
 	if x != nil {
-		return x.ClientID
+		return x.AccessTokenHash
 	}
 	return ""
 }
 
-type GenerateRefreshTokenRequest struct {
+type IntrospectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	SessionID   string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	NewAccessToken         string   `protobuf:"bytes,1,opt,name=NewAccessToken,proto3" json:"NewAccessToken,omitempty"`
+	IsAccessTokenRefreshed bool     `protobuf:"varint,2,opt,name=IsAccessTokenRefreshed,proto3" json:"IsAccessTokenRefreshed,omitempty"`
+	NewAccessTokenExpiry   int64    `protobuf:"varint,3,opt,name=NewAccessTokenExpiry,proto3" json:"NewAccessTokenExpiry,omitempty"`
+	IDToken                *IDToken `protobuf:"bytes,4,opt,name=IDToken,proto3" json:"IDToken,omitempty"`
+	Active                 bool     `protobuf:"varint,5,opt,name=Active,proto3" json:"Active,omitempty"`
+	Audience               []string `protobuf:"bytes,6,rep,name=Audience,proto3" json:"Audience,omitempty"`
+	ClientID               string   `protobuf:"bytes,7,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	Expiry                 int64    `protobuf:"varint,8,opt,name=Expiry,proto3" json:"Expiry,omitempty"`
+	IssuedAt               int64    `protobuf:"varint,9,opt,name=IssuedAt,proto3" json:"IssuedAt,omitempty"`
+	Issuer                 string   `protobuf:"bytes,10,opt,name=Issuer,proto3" json:"Issuer,omitempty"`
+	NotBefore              int64    `protobuf:"varint,11,opt,name=NotBefore,proto3" json:"NotBefore,omitempty"`
+	ObfuscatedSubject      string   `protobuf:"bytes,12,opt,name=ObfuscatedSubject,proto3" json:"ObfuscatedSubject,omitempty"`
+	Scope                  string   `protobuf:"bytes,13,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	Subject                string   `protobuf:"bytes,14,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	TokenType              string   `protobuf:"bytes,15,opt,name=TokenType,proto3" json:"TokenType,omitempty"`
+	TokenUse               string   `protobuf:"bytes,16,opt,name=TokenUse,proto3" json:"TokenUse,omitempty"`
+	Username               string   `protobuf:"bytes,17,opt,name=Username,proto3" json:"Username,omitempty"`
 }
 
-func (x *GenerateRefreshTokenRequest) Reset() {
-	*x = GenerateRefreshTokenRequest{}
+func (x *IntrospectResponse) Reset() {
+	*x = IntrospectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[13]
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateRefreshTokenRequest) String() string {
+func (x *IntrospectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateRefreshTokenRequest) ProtoMessage() {}
+func (*IntrospectResponse) ProtoMessage() {}
 
-func (x *GenerateRefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[13]
+func (x *IntrospectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,139 +1072,157 @@ func (x *GenerateRefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateRefreshTokenRequest.ProtoReflect.Descriptor instead.
-func (*GenerateRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use IntrospectResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GenerateRefreshTokenRequest) GetAccessToken() string {
+func (x *IntrospectResponse) GetNewAccessToken() string {
 	if x != nil {
-		return x.AccessToken
+		return x.NewAccessToken
 	}
 	return ""
 }
 
-func (x *GenerateRefreshTokenRequest) GetSessionID() string {
+func (x *IntrospectResponse) GetIsAccessTokenRefreshed() bool {
 	if x != nil {
-		return x.SessionID
+		return x.IsAccessTokenRefreshed
 	}
-	return ""
+	return false
 }
 
-type ClientTokenResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *IntrospectResponse) GetNewAccessTokenExpiry() int64 {
+	if x != nil {
+		return x.NewAccessTokenExpiry
+	}
+	return 0
+}
 
-	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
-	ExpiresIn   int64  `protobuf:"varint,2,opt,name=ExpiresIn,proto3" json:"ExpiresIn,omitempty"`
-	ExpiresAt   string `protobuf:"bytes,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
-	TokenType   string `protobuf:"bytes,4,opt,name=TokenType,proto3" json:"TokenType,omitempty"`
-	Scope       string `protobuf:"bytes,5,opt,name=Scope,proto3" json:"Scope,omitempty"`
-	Email       string `protobuf:"bytes,6,opt,name=Email,proto3" json:"Email,omitempty"`
+func (x *IntrospectResponse) GetIDToken() *IDToken {
+	if x != nil {
+		return x.IDToken
+	}
+	return nil
 }
 
-func (x *ClientTokenResponse) Reset() {
-	*x = ClientTokenResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *IntrospectResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
+	return false
 }
 
-func (x *ClientTokenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IntrospectResponse) GetAudience() []string {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
 }
 
-func (*ClientTokenResponse) ProtoMessage() {}
+func (x *IntrospectResponse) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
 
-func (x *ClientTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *IntrospectResponse) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ClientTokenResponse.ProtoReflect.Descriptor instead.
-func (*ClientTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{14}
+func (x *IntrospectResponse) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
 }
 
-func (x *ClientTokenResponse) GetAccessToken() string {
+func (x *IntrospectResponse) GetIssuer() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *ClientTokenResponse) GetExpiresIn() int64 {
+func (x *IntrospectResponse) GetNotBefore() int64 {
 	if x != nil {
-		return x.ExpiresIn
+		return x.NotBefore
 	}
 	return 0
 }
 
-func (x *ClientTokenResponse) GetExpiresAt() string {
+func (x *IntrospectResponse) GetObfuscatedSubject() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.ObfuscatedSubject
 	}
 	return ""
 }
 
-func (x *ClientTokenResponse) GetTokenType() string {
+func (x *IntrospectResponse) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetTokenType() string {
 	if x != nil {
 		return x.TokenType
 	}
 	return ""
 }
 
-func (x *ClientTokenResponse) GetScope() string {
+func (x *IntrospectResponse) GetTokenUse() string {
 	if x != nil {
-		return x.Scope
+		return x.TokenUse
 	}
 	return ""
 }
 
-func (x *ClientTokenResponse) GetEmail() string {
+func (x *IntrospectResponse) GetUsername() string {
 	if x != nil {
-		return x.Email
+		return x.Username
 	}
 	return ""
 }
 
-type RevokeAccessTokenRequest struct {
+type IntrospectVerificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientID    string `protobuf:"bytes,1,opt,name=clientID,proto3" json:"clientID,omitempty"`
-	AccessToken string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	SessionID   string `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Active   bool   `protobuf:"varint,1,opt,name=Active,proto3" json:"Active,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	ClientID string `protobuf:"bytes,3,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
 }
 
-func (x *RevokeAccessTokenRequest) Reset() {
-	*x = RevokeAccessTokenRequest{}
+func (x *IntrospectVerificationResponse) Reset() {
+	*x = IntrospectVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[15]
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RevokeAccessTokenRequest) String() string {
+func (x *IntrospectVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeAccessTokenRequest) ProtoMessage() {}
+func (*IntrospectVerificationResponse) ProtoMessage() {}
 
-func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[15]
+func (x *IntrospectVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,55 +1233,57 @@ func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeAccessTokenRequest.ProtoReflect.Descriptor instead.
-func (*RevokeAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use IntrospectVerificationResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RevokeAccessTokenRequest) GetClientID() string {
+func (x *IntrospectVerificationResponse) GetActive() bool {
 	if x != nil {
-		return x.ClientID
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-func (x *RevokeAccessTokenRequest) GetAccessToken() string {
+func (x *IntrospectVerificationResponse) GetEmail() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Email
 	}
 	return ""
 }
 
-func (x *RevokeAccessTokenRequest) GetSessionID() string {
+func (x *IntrospectVerificationResponse) GetClientID() string {
 	if x != nil {
-		return x.SessionID
+		return x.ClientID
 	}
 	return ""
 }
 
-type EmptyGrpcMessage struct {
+type IntrospectVerificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
 }
 
-func (x *EmptyGrpcMessage) Reset() {
-	*x = EmptyGrpcMessage{}
+func (x *IntrospectVerificationRequest) Reset() {
+	*x = IntrospectVerificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[16]
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EmptyGrpcMessage) String() string {
+func (x *IntrospectVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyGrpcMessage) ProtoMessage() {}
+func (*IntrospectVerificationRequest) ProtoMessage() {}
 
-func (x *EmptyGrpcMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[16]
+func (x *IntrospectVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,193 +1294,2436 @@ func (x *EmptyGrpcMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyGrpcMessage.ProtoReflect.Descriptor instead.
-func (*EmptyGrpcMessage) Descriptor() ([]byte, []int) {
-	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use IntrospectVerificationRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{17}
 }
 
-var File_proto_python_pyproto_tokenservice_proto protoreflect.FileDescriptor
+func (x *IntrospectVerificationRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
 
-var file_proto_python_pyproto_tokenservice_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x2f, 0x70,
-	0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+type GenerateVerificationTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
+	ClientID string `protobuf:"bytes,2,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+}
+
+func (x *GenerateVerificationTokenRequest) Reset() {
+	*x = GenerateVerificationTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateVerificationTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVerificationTokenRequest) ProtoMessage() {}
+
+func (x *GenerateVerificationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVerificationTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateVerificationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GenerateVerificationTokenRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GenerateVerificationTokenRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+type GenerateRefreshTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	SessionID   string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *GenerateRefreshTokenRequest) Reset() {
+	*x = GenerateRefreshTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateRefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRefreshTokenRequest) ProtoMessage() {}
+
+func (x *GenerateRefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GenerateRefreshTokenRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GenerateRefreshTokenRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type ClientTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	ExpiresIn   int64  `protobuf:"varint,2,opt,name=ExpiresIn,proto3" json:"ExpiresIn,omitempty"`
+	ExpiresAt   string `protobuf:"bytes,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	TokenType   string `protobuf:"bytes,4,opt,name=TokenType,proto3" json:"TokenType,omitempty"`
+	Scope       string `protobuf:"bytes,5,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	Email       string `protobuf:"bytes,6,opt,name=Email,proto3" json:"Email,omitempty"`
+}
+
+func (x *ClientTokenResponse) Reset() {
+	*x = ClientTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientTokenResponse) ProtoMessage() {}
+
+func (x *ClientTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientTokenResponse.ProtoReflect.Descriptor instead.
+func (*ClientTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClientTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ClientTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *ClientTokenResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ClientTokenResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *ClientTokenResponse) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ClientTokenResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RevokeAccessTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID    string `protobuf:"bytes,1,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	AccessToken string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	SessionID   string `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *RevokeAccessTokenRequest) Reset() {
+	*x = RevokeAccessTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenRequest) ProtoMessage() {}
+
+func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeAccessTokenRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *RevokeAccessTokenRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RevokeAccessTokenRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type RevokeLoginSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *RevokeLoginSessionsRequest) Reset() {
+	*x = RevokeLoginSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeLoginSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLoginSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeLoginSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLoginSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLoginSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokeLoginSessionsRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ClientID   string `protobuf:"bytes,2,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	UserAgent  string `protobuf:"bytes,3,opt,name=UserAgent,proto3" json:"UserAgent,omitempty"`
+	IPAddress  string `protobuf:"bytes,4,opt,name=IPAddress,proto3" json:"IPAddress,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	LastUsedAt int64  `protobuf:"varint,6,opt,name=LastUsedAt,proto3" json:"LastUsedAt,omitempty"`
+	Current    bool   `protobuf:"varint,7,opt,name=Current,proto3" json:"Current,omitempty"`
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SessionInfo) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIPAddress() string {
+	if x != nil {
+		return x.IPAddress
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID             string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	CurrentSessionID   string `protobuf:"bytes,2,opt,name=currentSessionID,proto3" json:"currentSessionID,omitempty"`
+	CurrentAccessToken string `protobuf:"bytes,3,opt,name=currentAccessToken,proto3" json:"currentAccessToken,omitempty"`
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListSessionsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetCurrentSessionID() string {
+	if x != nil {
+		return x.CurrentSessionID
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetCurrentAccessToken() string {
+	if x != nil {
+		return x.CurrentAccessToken
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*SessionInfo `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ID     string `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeSessionRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeSessionRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type RevokeAllSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAllSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevokeAllSessionsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ConsentSessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID        string   `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	ClientName      string   `protobuf:"bytes,2,opt,name=ClientName,proto3" json:"ClientName,omitempty"`
+	GrantedScopes   []string `protobuf:"bytes,3,rep,name=GrantedScopes,proto3" json:"GrantedScopes,omitempty"`
+	GrantedAudience []string `protobuf:"bytes,4,rep,name=GrantedAudience,proto3" json:"GrantedAudience,omitempty"`
+	GrantedAt       int64    `protobuf:"varint,5,opt,name=GrantedAt,proto3" json:"GrantedAt,omitempty"`
+	Remember        bool     `protobuf:"varint,6,opt,name=Remember,proto3" json:"Remember,omitempty"`
+}
+
+func (x *ConsentSessionInfo) Reset() {
+	*x = ConsentSessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsentSessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsentSessionInfo) ProtoMessage() {}
+
+func (x *ConsentSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsentSessionInfo.ProtoReflect.Descriptor instead.
+func (*ConsentSessionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ConsentSessionInfo) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *ConsentSessionInfo) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *ConsentSessionInfo) GetGrantedScopes() []string {
+	if x != nil {
+		return x.GrantedScopes
+	}
+	return nil
+}
+
+func (x *ConsentSessionInfo) GetGrantedAudience() []string {
+	if x != nil {
+		return x.GrantedAudience
+	}
+	return nil
+}
+
+func (x *ConsentSessionInfo) GetGrantedAt() int64 {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return 0
+}
+
+func (x *ConsentSessionInfo) GetRemember() bool {
+	if x != nil {
+		return x.Remember
+	}
+	return false
+}
+
+type ListConsentSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *ListConsentSessionsRequest) Reset() {
+	*x = ListConsentSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConsentSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsentSessionsRequest) ProtoMessage() {}
+
+func (x *ListConsentSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsentSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConsentSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListConsentSessionsRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type ListConsentSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*ConsentSessionInfo `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+}
+
+func (x *ListConsentSessionsResponse) Reset() {
+	*x = ListConsentSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConsentSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsentSessionsResponse) ProtoMessage() {}
+
+func (x *ListConsentSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsentSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsentSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListConsentSessionsResponse) GetSessions() []*ConsentSessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type ClientMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientName                string   `protobuf:"bytes,1,opt,name=ClientName,proto3" json:"ClientName,omitempty"`
+	RedirectURIs              []string `protobuf:"bytes,2,rep,name=RedirectURIs,proto3" json:"RedirectURIs,omitempty"`
+	GrantTypes                []string `protobuf:"bytes,3,rep,name=GrantTypes,proto3" json:"GrantTypes,omitempty"`
+	ResponseTypes             []string `protobuf:"bytes,4,rep,name=ResponseTypes,proto3" json:"ResponseTypes,omitempty"`
+	Scope                     []string `protobuf:"bytes,5,rep,name=Scope,proto3" json:"Scope,omitempty"`
+	Audience                  []string `protobuf:"bytes,6,rep,name=Audience,proto3" json:"Audience,omitempty"`
+	TokenEndpointAuthMethod   string   `protobuf:"bytes,7,opt,name=TokenEndpointAuthMethod,proto3" json:"TokenEndpointAuthMethod,omitempty"`
+	LogoURI                   string   `protobuf:"bytes,8,opt,name=LogoURI,proto3" json:"LogoURI,omitempty"`
+	Trusted                   bool     `protobuf:"varint,9,opt,name=Trusted,proto3" json:"Trusted,omitempty"`
+	LoginRememberForSeconds   int32    `protobuf:"varint,10,opt,name=LoginRememberForSeconds,proto3" json:"LoginRememberForSeconds,omitempty"`
+	ConsentRememberForSeconds int32    `protobuf:"varint,11,opt,name=ConsentRememberForSeconds,proto3" json:"ConsentRememberForSeconds,omitempty"`
+}
+
+func (x *ClientMetadata) Reset() {
+	*x = ClientMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientMetadata) ProtoMessage() {}
+
+func (x *ClientMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientMetadata.ProtoReflect.Descriptor instead.
+func (*ClientMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClientMetadata) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *ClientMetadata) GetRedirectURIs() []string {
+	if x != nil {
+		return x.RedirectURIs
+	}
+	return nil
+}
+
+func (x *ClientMetadata) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *ClientMetadata) GetResponseTypes() []string {
+	if x != nil {
+		return x.ResponseTypes
+	}
+	return nil
+}
+
+func (x *ClientMetadata) GetScope() []string {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ClientMetadata) GetAudience() []string {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *ClientMetadata) GetTokenEndpointAuthMethod() string {
+	if x != nil {
+		return x.TokenEndpointAuthMethod
+	}
+	return ""
+}
+
+func (x *ClientMetadata) GetLogoURI() string {
+	if x != nil {
+		return x.LogoURI
+	}
+	return ""
+}
+
+func (x *ClientMetadata) GetTrusted() bool {
+	if x != nil {
+		return x.Trusted
+	}
+	return false
+}
+
+func (x *ClientMetadata) GetLoginRememberForSeconds() int32 {
+	if x != nil {
+		return x.LoginRememberForSeconds
+	}
+	return 0
+}
+
+func (x *ClientMetadata) GetConsentRememberForSeconds() int32 {
+	if x != nil {
+		return x.ConsentRememberForSeconds
+	}
+	return 0
+}
+
+type CreateClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *ClientMetadata `protobuf:"bytes,1,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+}
+
+func (x *CreateClientRequest) Reset() {
+	*x = CreateClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientRequest) ProtoMessage() {}
+
+func (x *CreateClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientRequest.ProtoReflect.Descriptor instead.
+func (*CreateClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateClientRequest) GetMetadata() *ClientMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type UpdateClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID string          `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	Metadata *ClientMetadata `protobuf:"bytes,2,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+}
+
+func (x *UpdateClientRequest) Reset() {
+	*x = UpdateClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClientRequest) ProtoMessage() {}
+
+func (x *UpdateClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClientRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateClientRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *UpdateClientRequest) GetMetadata() *ClientMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type RotateClientSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID string `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+}
+
+func (x *RotateClientSecretRequest) Reset() {
+	*x = RotateClientSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateClientSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateClientSecretRequest) ProtoMessage() {}
+
+func (x *RotateClientSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateClientSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateClientSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RotateClientSecretRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+type DeleteClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID string `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+}
+
+func (x *DeleteClientRequest) Reset() {
+	*x = DeleteClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClientRequest) ProtoMessage() {}
+
+func (x *DeleteClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClientRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteClientRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+type ClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID     string          `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	ClientSecret string          `protobuf:"bytes,2,opt,name=ClientSecret,proto3" json:"ClientSecret,omitempty"`
+	Metadata     *ClientMetadata `protobuf:"bytes,3,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+}
+
+func (x *ClientResponse) Reset() {
+	*x = ClientResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientResponse) ProtoMessage() {}
+
+func (x *ClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientResponse.ProtoReflect.Descriptor instead.
+func (*ClientResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClientResponse) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *ClientResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *ClientResponse) GetMetadata() *ClientMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type IssueInitialAccessTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresInSeconds int32 `protobuf:"varint,1,opt,name=ExpiresInSeconds,proto3" json:"ExpiresInSeconds,omitempty"`
+}
+
+func (x *IssueInitialAccessTokenRequest) Reset() {
+	*x = IssueInitialAccessTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueInitialAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueInitialAccessTokenRequest) ProtoMessage() {}
+
+func (x *IssueInitialAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueInitialAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueInitialAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *IssueInitialAccessTokenRequest) GetExpiresInSeconds() int32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type InitialAccessTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (x *InitialAccessTokenResponse) Reset() {
+	*x = InitialAccessTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitialAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitialAccessTokenResponse) ProtoMessage() {}
+
+func (x *InitialAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitialAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*InitialAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InitialAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *InitialAccessTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RegisterClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitialAccessToken string          `protobuf:"bytes,1,opt,name=InitialAccessToken,proto3" json:"InitialAccessToken,omitempty"`
+	Metadata           *ClientMetadata `protobuf:"bytes,2,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+}
+
+func (x *RegisterClientRequest) Reset() {
+	*x = RegisterClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClientRequest) ProtoMessage() {}
+
+func (x *RegisterClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClientRequest.ProtoReflect.Descriptor instead.
+func (*RegisterClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RegisterClientRequest) GetInitialAccessToken() string {
+	if x != nil {
+		return x.InitialAccessToken
+	}
+	return ""
+}
+
+func (x *RegisterClientRequest) GetMetadata() *ClientMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type RegisteredClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID                string `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	RegistrationAccessToken string `protobuf:"bytes,2,opt,name=RegistrationAccessToken,proto3" json:"RegistrationAccessToken,omitempty"`
+}
+
+func (x *RegisteredClientRequest) Reset() {
+	*x = RegisteredClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredClientRequest) ProtoMessage() {}
+
+func (x *RegisteredClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredClientRequest.ProtoReflect.Descriptor instead.
+func (*RegisteredClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RegisteredClientRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *RegisteredClientRequest) GetRegistrationAccessToken() string {
+	if x != nil {
+		return x.RegistrationAccessToken
+	}
+	return ""
+}
+
+type UpdateRegisteredClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID                string          `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	RegistrationAccessToken string          `protobuf:"bytes,2,opt,name=RegistrationAccessToken,proto3" json:"RegistrationAccessToken,omitempty"`
+	Metadata                *ClientMetadata `protobuf:"bytes,3,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+}
+
+func (x *UpdateRegisteredClientRequest) Reset() {
+	*x = UpdateRegisteredClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRegisteredClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRegisteredClientRequest) ProtoMessage() {}
+
+func (x *UpdateRegisteredClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRegisteredClientRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRegisteredClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateRegisteredClientRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *UpdateRegisteredClientRequest) GetRegistrationAccessToken() string {
+	if x != nil {
+		return x.RegistrationAccessToken
+	}
+	return ""
+}
+
+func (x *UpdateRegisteredClientRequest) GetMetadata() *ClientMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type RegisteredClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientID                string          `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	ClientSecret            string          `protobuf:"bytes,2,opt,name=ClientSecret,proto3" json:"ClientSecret,omitempty"`
+	ClientIDIssuedAt        int64           `protobuf:"varint,3,opt,name=ClientIDIssuedAt,proto3" json:"ClientIDIssuedAt,omitempty"`
+	RegistrationAccessToken string          `protobuf:"bytes,4,opt,name=RegistrationAccessToken,proto3" json:"RegistrationAccessToken,omitempty"`
+	Metadata                *ClientMetadata `protobuf:"bytes,5,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+}
+
+func (x *RegisteredClientResponse) Reset() {
+	*x = RegisteredClientResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredClientResponse) ProtoMessage() {}
+
+func (x *RegisteredClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredClientResponse.ProtoReflect.Descriptor instead.
+func (*RegisteredClientResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RegisteredClientResponse) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *RegisteredClientResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *RegisteredClientResponse) GetClientIDIssuedAt() int64 {
+	if x != nil {
+		return x.ClientIDIssuedAt
+	}
+	return 0
+}
+
+func (x *RegisteredClientResponse) GetRegistrationAccessToken() string {
+	if x != nil {
+		return x.RegistrationAccessToken
+	}
+	return ""
+}
+
+func (x *RegisteredClientResponse) GetMetadata() *ClientMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ExchangeForAudienceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectToken     string   `protobuf:"bytes,1,opt,name=SubjectToken,proto3" json:"SubjectToken,omitempty"`
+	ActorToken       string   `protobuf:"bytes,2,opt,name=ActorToken,proto3" json:"ActorToken,omitempty"`
+	Audience         string   `protobuf:"bytes,3,opt,name=Audience,proto3" json:"Audience,omitempty"`
+	Scope            []string `protobuf:"bytes,4,rep,name=Scope,proto3" json:"Scope,omitempty"`
+	ExpiresInSeconds int32    `protobuf:"varint,5,opt,name=ExpiresInSeconds,proto3" json:"ExpiresInSeconds,omitempty"`
+}
+
+func (x *ExchangeForAudienceRequest) Reset() {
+	*x = ExchangeForAudienceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeForAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeForAudienceRequest) ProtoMessage() {}
+
+func (x *ExchangeForAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeForAudienceRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeForAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExchangeForAudienceRequest) GetSubjectToken() string {
+	if x != nil {
+		return x.SubjectToken
+	}
+	return ""
+}
+
+func (x *ExchangeForAudienceRequest) GetActorToken() string {
+	if x != nil {
+		return x.ActorToken
+	}
+	return ""
+}
+
+func (x *ExchangeForAudienceRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *ExchangeForAudienceRequest) GetScope() []string {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ExchangeForAudienceRequest) GetExpiresInSeconds() int32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type ExchangeForAudienceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken     string   `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	IssuedTokenType string   `protobuf:"bytes,2,opt,name=IssuedTokenType,proto3" json:"IssuedTokenType,omitempty"`
+	TokenType       string   `protobuf:"bytes,3,opt,name=TokenType,proto3" json:"TokenType,omitempty"`
+	ExpiresIn       int64    `protobuf:"varint,4,opt,name=ExpiresIn,proto3" json:"ExpiresIn,omitempty"`
+	Scope           []string `protobuf:"bytes,5,rep,name=Scope,proto3" json:"Scope,omitempty"`
+}
+
+func (x *ExchangeForAudienceResponse) Reset() {
+	*x = ExchangeForAudienceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeForAudienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeForAudienceResponse) ProtoMessage() {}
+
+func (x *ExchangeForAudienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeForAudienceResponse.ProtoReflect.Descriptor instead.
+func (*ExchangeForAudienceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExchangeForAudienceResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExchangeForAudienceResponse) GetIssuedTokenType() string {
+	if x != nil {
+		return x.IssuedTokenType
+	}
+	return ""
+}
+
+func (x *ExchangeForAudienceResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *ExchangeForAudienceResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *ExchangeForAudienceResponse) GetScope() []string {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type JSONWebKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kid string `protobuf:"bytes,1,opt,name=Kid,proto3" json:"Kid,omitempty"`
+	Kty string `protobuf:"bytes,2,opt,name=Kty,proto3" json:"Kty,omitempty"`
+	Use string `protobuf:"bytes,3,opt,name=Use,proto3" json:"Use,omitempty"`
+	Alg string `protobuf:"bytes,4,opt,name=Alg,proto3" json:"Alg,omitempty"`
+	N   string `protobuf:"bytes,5,opt,name=N,proto3" json:"N,omitempty"`
+	E   string `protobuf:"bytes,6,opt,name=E,proto3" json:"E,omitempty"`
+	Crv string `protobuf:"bytes,7,opt,name=Crv,proto3" json:"Crv,omitempty"`
+	X   string `protobuf:"bytes,8,opt,name=X,proto3" json:"X,omitempty"`
+	Y   string `protobuf:"bytes,9,opt,name=Y,proto3" json:"Y,omitempty"`
+}
+
+func (x *JSONWebKey) Reset() {
+	*x = JSONWebKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONWebKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONWebKey) ProtoMessage() {}
+
+func (x *JSONWebKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONWebKey.ProtoReflect.Descriptor instead.
+func (*JSONWebKey) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *JSONWebKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+type TokenExchangeKeySetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*JSONWebKey `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+}
+
+func (x *TokenExchangeKeySetResponse) Reset() {
+	*x = TokenExchangeKeySetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenExchangeKeySetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenExchangeKeySetResponse) ProtoMessage() {}
+
+func (x *TokenExchangeKeySetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenExchangeKeySetResponse.ProtoReflect.Descriptor instead.
+func (*TokenExchangeKeySetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TokenExchangeKeySetResponse) GetKeys() []*JSONWebKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type EmptyGrpcMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyGrpcMessage) Reset() {
+	*x = EmptyGrpcMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyGrpcMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyGrpcMessage) ProtoMessage() {}
+
+func (x *EmptyGrpcMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_python_pyproto_tokenservice_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyGrpcMessage.ProtoReflect.Descriptor instead.
+func (*EmptyGrpcMessage) Descriptor() ([]byte, []int) {
+	return file_proto_python_pyproto_tokenservice_proto_rawDescGZIP(), []int{47}
+}
+
+var File_proto_python_pyproto_tokenservice_proto protoreflect.FileDescriptor
+
+var file_proto_python_pyproto_tokenservice_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x2f, 0x70,
+	0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7f, 0x0a, 0x0b, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
 	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x22, 0x6c, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x12, 0x2e, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x22, 0x35, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x12, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x63, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x63, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x41,
+	0x6d, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x41, 0x6d, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x13, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x6f,
+	0x22, 0x40, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x53, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x53, 0x6b,
+	0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x6f,
+	0x55, 0x52, 0x49, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4c, 0x6f, 0x67, 0x6f, 0x55,
+	0x52, 0x49, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0x37, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
+	0x10, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x4c, 0x6f, 0x67, 0x6f, 0x55, 0x52, 0x49, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4c,
+	0x6f, 0x67, 0x6f, 0x55, 0x52, 0x49, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x53, 0x6b, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x53, 0x6b, 0x69, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x14, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x37,
+	0x0a, 0x15, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x64, 0x69, 0x72,
 	0x65, 0x63, 0x74, 0x54, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x22, 0x42, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x37, 0x0a, 0x15, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x54, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x54, 0x6f, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x55, 0x52, 0x49, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a,
-	0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x72, 0x6f,
-	0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x22, 0xde, 0x01, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x55, 0x52, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x55, 0x52, 0x49, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x11,
+	0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0x7d, 0x0a, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x0b,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0xba, 0x04, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x36, 0x0a, 0x16, 0x49, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x16, 0x49, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x4e, 0x65, 0x77, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x07, 0x49,
+	0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x49,
+	0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4e,
+	0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x4f, 0x62, 0x66,
+	0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a,
+	0x1e, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x1d, 0x49, 0x6e, 0x74,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x20,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x22, 0x5d, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0xbd, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0x76, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x36, 0x0a, 0x1a, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x22, 0xcd, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x49,
+	0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c, 0x61, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2e, 0x0a,
+	0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x40, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x3e, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22,
+	0x32, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x36, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x4e, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb2, 0x03, 0x0a, 0x0e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x6f, 0x55, 0x52, 0x49, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4c, 0x6f, 0x67, 0x6f, 0x55, 0x52, 0x49, 0x12, 0x18, 0x0a, 0x07, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x3c, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x19, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x42, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x5e, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x37, 0x0a, 0x19, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x7d, 0x0a,
+	0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x2b, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4c, 0x0a, 0x1e,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x50, 0x0a, 0x1a, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x74, 0x0a, 0x15,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
 	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x7d, 0x0a, 0x07,
-	0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0xba, 0x04, 0x0a, 0x12,
-	0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4e, 0x65, 0x77, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x49, 0x73,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x49, 0x73, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x14, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x07, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66,
-	0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x42, 0x65,
-	0x66, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x1e, 0x49, 0x6e, 0x74, 0x72,
-	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x1d, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x5d, 0x0a,
-	0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xbd, 0x01, 0x0a,
-	0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x49, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x49, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x76, 0x0a, 0x18,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x47, 0x72, 0x70,
-	0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xd6, 0x04, 0x0a, 0x0c, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x49, 0x6e, 0x74,
-	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x12, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x49, 0x6e,
-	0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x56, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x21, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x1b, 0x49, 0x6e,
-	0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x49, 0x6e, 0x74, 0x72,
-	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x49, 0x6e, 0x74, 0x72,
-	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x14,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x11,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45,
+	0x09, 0x52, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x6f, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x17, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x38, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xed, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x38, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbe, 0x01, 0x0a, 0x1a, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x1b, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x4a, 0x53, 0x4f, 0x4e,
+	0x57, 0x65, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x41, 0x6c, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x6c, 0x67, 0x12, 0x0c,
+	0x0a, 0x01, 0x4e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x4e, 0x12, 0x0c, 0x0a, 0x01,
+	0x45, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x45, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x72,
+	0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x43, 0x72, 0x76, 0x12, 0x0c, 0x0a, 0x01,
+	0x58, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x58, 0x12, 0x0c, 0x0a, 0x01, 0x59, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x59, 0x22, 0x3e, 0x0a, 0x1b, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65, 0x62, 0x4b,
+	0x65, 0x79, 0x52, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x9a, 0x0f, 0x0a,
+	0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x74, 0x12, 0x15, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x15, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x12,
+	0x2e, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x19, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x60, 0x0a, 0x1b, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1e, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x47, 0x72, 0x70, 0x63, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1b, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45,
 	0x6d, 0x70, 0x74, 0x79, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x00, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x47, 0x72, 0x70, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a,
+	0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x47, 0x72, 0x70, 0x63, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x17, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x18, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x47, 0x72, 0x70, 0x63, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1b, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x47, 0x72,
+	0x70, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1c, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1334,51 +3738,129 @@ func file_proto_python_pyproto_tokenservice_proto_rawDescGZIP() []byte {
 	return file_proto_python_pyproto_tokenservice_proto_rawDescData
 }
 
-var file_proto_python_pyproto_tokenservice_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_python_pyproto_tokenservice_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_proto_python_pyproto_tokenservice_proto_goTypes = []interface{}{
 	(*UserProfile)(nil),                      // 0: UserProfile
 	(*AcceptLoginRequest)(nil),               // 1: AcceptLoginRequest
 	(*AcceptLoginResponse)(nil),              // 2: AcceptLoginResponse
-	(*AcceptConsentRequest)(nil),             // 3: AcceptConsentRequest
-	(*AcceptConsentResponse)(nil),            // 4: AcceptConsentResponse
-	(*TokenExchangeRequest)(nil),             // 5: TokenExchangeRequest
-	(*TokenExchangeResponse)(nil),            // 6: TokenExchangeResponse
-	(*IntrospectRequest)(nil),                // 7: IntrospectRequest
-	(*IDToken)(nil),                          // 8: IDToken
-	(*IntrospectResponse)(nil),               // 9: IntrospectResponse
-	(*IntrospectVerificationResponse)(nil),   // 10: IntrospectVerificationResponse
-	(*IntrospectVerificationRequest)(nil),    // 11: IntrospectVerificationRequest
-	(*GenerateVerificationTokenRequest)(nil), // 12: GenerateVerificationTokenRequest
-	(*GenerateRefreshTokenRequest)(nil),      // 13: GenerateRefreshTokenRequest
-	(*ClientTokenResponse)(nil),              // 14: ClientTokenResponse
-	(*RevokeAccessTokenRequest)(nil),         // 15: RevokeAccessTokenRequest
-	(*EmptyGrpcMessage)(nil),                 // 16: EmptyGrpcMessage
+	(*GetLoginRequestRequest)(nil),           // 3: GetLoginRequestRequest
+	(*GetLoginRequestResponse)(nil),          // 4: GetLoginRequestResponse
+	(*AcceptConsentRequest)(nil),             // 5: AcceptConsentRequest
+	(*AcceptConsentResponse)(nil),            // 6: AcceptConsentResponse
+	(*GetConsentRequest)(nil),                // 7: GetConsentRequest
+	(*GetConsentResponse)(nil),               // 8: GetConsentResponse
+	(*RejectConsentRequest)(nil),             // 9: RejectConsentRequest
+	(*RejectConsentResponse)(nil),            // 10: RejectConsentResponse
+	(*TokenExchangeRequest)(nil),             // 11: TokenExchangeRequest
+	(*TokenExchangeResponse)(nil),            // 12: TokenExchangeResponse
+	(*IntrospectRequest)(nil),                // 13: IntrospectRequest
+	(*IDToken)(nil),                          // 14: IDToken
+	(*IntrospectResponse)(nil),               // 15: IntrospectResponse
+	(*IntrospectVerificationResponse)(nil),   // 16: IntrospectVerificationResponse
+	(*IntrospectVerificationRequest)(nil),    // 17: IntrospectVerificationRequest
+	(*GenerateVerificationTokenRequest)(nil), // 18: GenerateVerificationTokenRequest
+	(*GenerateRefreshTokenRequest)(nil),      // 19: GenerateRefreshTokenRequest
+	(*ClientTokenResponse)(nil),              // 20: ClientTokenResponse
+	(*RevokeAccessTokenRequest)(nil),         // 21: RevokeAccessTokenRequest
+	(*RevokeLoginSessionsRequest)(nil),       // 22: RevokeLoginSessionsRequest
+	(*SessionInfo)(nil),                      // 23: SessionInfo
+	(*ListSessionsRequest)(nil),              // 24: ListSessionsRequest
+	(*ListSessionsResponse)(nil),             // 25: ListSessionsResponse
+	(*RevokeSessionRequest)(nil),             // 26: RevokeSessionRequest
+	(*RevokeAllSessionsRequest)(nil),         // 27: RevokeAllSessionsRequest
+	(*ConsentSessionInfo)(nil),               // 28: ConsentSessionInfo
+	(*ListConsentSessionsRequest)(nil),       // 29: ListConsentSessionsRequest
+	(*ListConsentSessionsResponse)(nil),      // 30: ListConsentSessionsResponse
+	(*ClientMetadata)(nil),                   // 31: ClientMetadata
+	(*CreateClientRequest)(nil),              // 32: CreateClientRequest
+	(*UpdateClientRequest)(nil),              // 33: UpdateClientRequest
+	(*RotateClientSecretRequest)(nil),        // 34: RotateClientSecretRequest
+	(*DeleteClientRequest)(nil),              // 35: DeleteClientRequest
+	(*ClientResponse)(nil),                   // 36: ClientResponse
+	(*IssueInitialAccessTokenRequest)(nil),   // 37: IssueInitialAccessTokenRequest
+	(*InitialAccessTokenResponse)(nil),       // 38: InitialAccessTokenResponse
+	(*RegisterClientRequest)(nil),            // 39: RegisterClientRequest
+	(*RegisteredClientRequest)(nil),          // 40: RegisteredClientRequest
+	(*UpdateRegisteredClientRequest)(nil),    // 41: UpdateRegisteredClientRequest
+	(*RegisteredClientResponse)(nil),         // 42: RegisteredClientResponse
+	(*ExchangeForAudienceRequest)(nil),       // 43: ExchangeForAudienceRequest
+	(*ExchangeForAudienceResponse)(nil),      // 44: ExchangeForAudienceResponse
+	(*JSONWebKey)(nil),                       // 45: JSONWebKey
+	(*TokenExchangeKeySetResponse)(nil),      // 46: TokenExchangeKeySetResponse
+	(*EmptyGrpcMessage)(nil),                 // 47: EmptyGrpcMessage
 }
 var file_proto_python_pyproto_tokenservice_proto_depIdxs = []int32{
 	0,  // 0: AcceptLoginRequest.UserProfile:type_name -> UserProfile
 	0,  // 1: IDToken.UserProfile:type_name -> UserProfile
-	8,  // 2: IntrospectResponse.IDToken:type_name -> IDToken
-	1,  // 3: TokenService.AcceptLogin:input_type -> AcceptLoginRequest
-	3,  // 4: TokenService.AcceptConsent:input_type -> AcceptConsentRequest
-	5,  // 5: TokenService.ExchangeToken:input_type -> TokenExchangeRequest
-	7,  // 6: TokenService.Introspect:input_type -> IntrospectRequest
-	12, // 7: TokenService.GenerateVerificationToken:input_type -> GenerateVerificationTokenRequest
-	11, // 8: TokenService.IntrospectVerificationToken:input_type -> IntrospectVerificationRequest
-	13, // 9: TokenService.GenerateRefreshToken:input_type -> GenerateRefreshTokenRequest
-	15, // 10: TokenService.RevokeAccessToken:input_type -> RevokeAccessTokenRequest
-	2,  // 11: TokenService.AcceptLogin:output_type -> AcceptLoginResponse
-	4,  // 12: TokenService.AcceptConsent:output_type -> AcceptConsentResponse
-	6,  // 13: TokenService.ExchangeToken:output_type -> TokenExchangeResponse
-	9,  // 14: TokenService.Introspect:output_type -> IntrospectResponse
-	14, // 15: TokenService.GenerateVerificationToken:output_type -> ClientTokenResponse
-	10, // 16: TokenService.IntrospectVerificationToken:output_type -> IntrospectVerificationResponse
-	6,  // 17: TokenService.GenerateRefreshToken:output_type -> TokenExchangeResponse
-	16, // 18: TokenService.RevokeAccessToken:output_type -> EmptyGrpcMessage
-	11, // [11:19] is the sub-list for method output_type
-	3,  // [3:11] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	14, // 2: IntrospectResponse.IDToken:type_name -> IDToken
+	23, // 3: ListSessionsResponse.Sessions:type_name -> SessionInfo
+	28, // 4: ListConsentSessionsResponse.Sessions:type_name -> ConsentSessionInfo
+	31, // 5: CreateClientRequest.Metadata:type_name -> ClientMetadata
+	31, // 6: UpdateClientRequest.Metadata:type_name -> ClientMetadata
+	31, // 7: ClientResponse.Metadata:type_name -> ClientMetadata
+	31, // 8: RegisterClientRequest.Metadata:type_name -> ClientMetadata
+	31, // 9: UpdateRegisteredClientRequest.Metadata:type_name -> ClientMetadata
+	31, // 10: RegisteredClientResponse.Metadata:type_name -> ClientMetadata
+	45, // 11: TokenExchangeKeySetResponse.Keys:type_name -> JSONWebKey
+	1,  // 12: TokenService.AcceptLogin:input_type -> AcceptLoginRequest
+	3,  // 13: TokenService.GetLoginRequest:input_type -> GetLoginRequestRequest
+	5,  // 14: TokenService.AcceptConsent:input_type -> AcceptConsentRequest
+	7,  // 15: TokenService.GetConsent:input_type -> GetConsentRequest
+	9,  // 16: TokenService.RejectConsent:input_type -> RejectConsentRequest
+	11, // 17: TokenService.ExchangeToken:input_type -> TokenExchangeRequest
+	13, // 18: TokenService.Introspect:input_type -> IntrospectRequest
+	18, // 19: TokenService.GenerateVerificationToken:input_type -> GenerateVerificationTokenRequest
+	17, // 20: TokenService.IntrospectVerificationToken:input_type -> IntrospectVerificationRequest
+	19, // 21: TokenService.GenerateRefreshToken:input_type -> GenerateRefreshTokenRequest
+	21, // 22: TokenService.RevokeAccessToken:input_type -> RevokeAccessTokenRequest
+	22, // 23: TokenService.RevokeLoginSessions:input_type -> RevokeLoginSessionsRequest
+	24, // 24: TokenService.ListSessions:input_type -> ListSessionsRequest
+	26, // 25: TokenService.RevokeSession:input_type -> RevokeSessionRequest
+	27, // 26: TokenService.RevokeAllSessions:input_type -> RevokeAllSessionsRequest
+	29, // 27: TokenService.ListConsentSessions:input_type -> ListConsentSessionsRequest
+	32, // 28: TokenService.CreateClient:input_type -> CreateClientRequest
+	33, // 29: TokenService.UpdateClient:input_type -> UpdateClientRequest
+	34, // 30: TokenService.RotateClientSecret:input_type -> RotateClientSecretRequest
+	35, // 31: TokenService.DeleteClient:input_type -> DeleteClientRequest
+	37, // 32: TokenService.IssueInitialAccessToken:input_type -> IssueInitialAccessTokenRequest
+	39, // 33: TokenService.RegisterClient:input_type -> RegisterClientRequest
+	40, // 34: TokenService.GetRegisteredClient:input_type -> RegisteredClientRequest
+	41, // 35: TokenService.UpdateRegisteredClient:input_type -> UpdateRegisteredClientRequest
+	40, // 36: TokenService.DeleteRegisteredClient:input_type -> RegisteredClientRequest
+	43, // 37: TokenService.ExchangeForAudience:input_type -> ExchangeForAudienceRequest
+	47, // 38: TokenService.GetTokenExchangeKeySet:input_type -> EmptyGrpcMessage
+	2,  // 39: TokenService.AcceptLogin:output_type -> AcceptLoginResponse
+	4,  // 40: TokenService.GetLoginRequest:output_type -> GetLoginRequestResponse
+	6,  // 41: TokenService.AcceptConsent:output_type -> AcceptConsentResponse
+	8,  // 42: TokenService.GetConsent:output_type -> GetConsentResponse
+	10, // 43: TokenService.RejectConsent:output_type -> RejectConsentResponse
+	12, // 44: TokenService.ExchangeToken:output_type -> TokenExchangeResponse
+	15, // 45: TokenService.Introspect:output_type -> IntrospectResponse
+	20, // 46: TokenService.GenerateVerificationToken:output_type -> ClientTokenResponse
+	16, // 47: TokenService.IntrospectVerificationToken:output_type -> IntrospectVerificationResponse
+	12, // 48: TokenService.GenerateRefreshToken:output_type -> TokenExchangeResponse
+	47, // 49: TokenService.RevokeAccessToken:output_type -> EmptyGrpcMessage
+	47, // 50: TokenService.RevokeLoginSessions:output_type -> EmptyGrpcMessage
+	25, // 51: TokenService.ListSessions:output_type -> ListSessionsResponse
+	47, // 52: TokenService.RevokeSession:output_type -> EmptyGrpcMessage
+	47, // 53: TokenService.RevokeAllSessions:output_type -> EmptyGrpcMessage
+	30, // 54: TokenService.ListConsentSessions:output_type -> ListConsentSessionsResponse
+	36, // 55: TokenService.CreateClient:output_type -> ClientResponse
+	36, // 56: TokenService.UpdateClient:output_type -> ClientResponse
+	36, // 57: TokenService.RotateClientSecret:output_type -> ClientResponse
+	47, // 58: TokenService.DeleteClient:output_type -> EmptyGrpcMessage
+	38, // 59: TokenService.IssueInitialAccessToken:output_type -> InitialAccessTokenResponse
+	42, // 60: TokenService.RegisterClient:output_type -> RegisteredClientResponse
+	42, // 61: TokenService.GetRegisteredClient:output_type -> RegisteredClientResponse
+	42, // 62: TokenService.UpdateRegisteredClient:output_type -> RegisteredClientResponse
+	47, // 63: TokenService.DeleteRegisteredClient:output_type -> EmptyGrpcMessage
+	44, // 64: TokenService.ExchangeForAudience:output_type -> ExchangeForAudienceResponse
+	46, // 65: TokenService.GetTokenExchangeKeySet:output_type -> TokenExchangeKeySetResponse
+	39, // [39:66] is the sub-list for method output_type
+	12, // [12:39] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_proto_python_pyproto_tokenservice_proto_init() }
@@ -1399,8 +3881,140 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptLoginRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoginRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoginRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptConsentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptConsentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectConsentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectConsentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenExchangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenExchangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1411,8 +4025,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptLoginResponse); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntrospectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1423,8 +4037,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptConsentRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IDToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1435,8 +4049,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptConsentResponse); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntrospectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1447,8 +4061,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenExchangeRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntrospectVerificationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1459,8 +4073,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenExchangeResponse); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntrospectVerificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1471,8 +4085,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntrospectRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateVerificationTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1483,8 +4097,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IDToken); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateRefreshTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1495,8 +4109,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntrospectResponse); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1507,8 +4121,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntrospectVerificationResponse); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1519,8 +4133,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntrospectVerificationRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeLoginSessionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1531,8 +4145,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateVerificationTokenRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1543,8 +4157,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateRefreshTokenRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1555,8 +4169,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientTokenResponse); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1567,8 +4181,8 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeAccessTokenRequest); i {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1579,7 +4193,247 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 				return nil
 			}
 		}
-		file_proto_python_pyproto_tokenservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAllSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsentSessionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConsentSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConsentSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateClientSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueInitialAccessTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitialAccessTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRegisteredClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredClientResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeForAudienceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeForAudienceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONWebKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenExchangeKeySetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_python_pyproto_tokenservice_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyGrpcMessage); i {
 			case 0:
 				return &v.state
@@ -1598,7 +4452,7 @@ func file_proto_python_pyproto_tokenservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_python_pyproto_tokenservice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,13 +23,32 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TokenServiceClient interface {
 	AcceptLogin(ctx context.Context, in *AcceptLoginRequest, opts ...grpc.CallOption) (*AcceptLoginResponse, error)
+	GetLoginRequest(ctx context.Context, in *GetLoginRequestRequest, opts ...grpc.CallOption) (*GetLoginRequestResponse, error)
 	AcceptConsent(ctx context.Context, in *AcceptConsentRequest, opts ...grpc.CallOption) (*AcceptConsentResponse, error)
+	GetConsent(ctx context.Context, in *GetConsentRequest, opts ...grpc.CallOption) (*GetConsentResponse, error)
+	RejectConsent(ctx context.Context, in *RejectConsentRequest, opts ...grpc.CallOption) (*RejectConsentResponse, error)
 	ExchangeToken(ctx context.Context, in *TokenExchangeRequest, opts ...grpc.CallOption) (*TokenExchangeResponse, error)
 	Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectResponse, error)
 	GenerateVerificationToken(ctx context.Context, in *GenerateVerificationTokenRequest, opts ...grpc.CallOption) (*ClientTokenResponse, error)
 	IntrospectVerificationToken(ctx context.Context, in *IntrospectVerificationRequest, opts ...grpc.CallOption) (*IntrospectVerificationResponse, error)
 	GenerateRefreshToken(ctx context.Context, in *GenerateRefreshTokenRequest, opts ...grpc.CallOption) (*TokenExchangeResponse, error)
 	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error)
+	RevokeLoginSessions(ctx context.Context, in *RevokeLoginSessionsRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error)
+	ListConsentSessions(ctx context.Context, in *ListConsentSessionsRequest, opts ...grpc.CallOption) (*ListConsentSessionsResponse, error)
+	CreateClient(ctx context.Context, in *CreateClientRequest, opts ...grpc.CallOption) (*ClientResponse, error)
+	UpdateClient(ctx context.Context, in *UpdateClientRequest, opts ...grpc.CallOption) (*ClientResponse, error)
+	RotateClientSecret(ctx context.Context, in *RotateClientSecretRequest, opts ...grpc.CallOption) (*ClientResponse, error)
+	DeleteClient(ctx context.Context, in *DeleteClientRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error)
+	IssueInitialAccessToken(ctx context.Context, in *IssueInitialAccessTokenRequest, opts ...grpc.CallOption) (*InitialAccessTokenResponse, error)
+	RegisterClient(ctx context.Context, in *RegisterClientRequest, opts ...grpc.CallOption) (*RegisteredClientResponse, error)
+	GetRegisteredClient(ctx context.Context, in *RegisteredClientRequest, opts ...grpc.CallOption) (*RegisteredClientResponse, error)
+	UpdateRegisteredClient(ctx context.Context, in *UpdateRegisteredClientRequest, opts ...grpc.CallOption) (*RegisteredClientResponse, error)
+	DeleteRegisteredClient(ctx context.Context, in *RegisteredClientRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error)
+	ExchangeForAudience(ctx context.Context, in *ExchangeForAudienceRequest, opts ...grpc.CallOption) (*ExchangeForAudienceResponse, error)
+	GetTokenExchangeKeySet(ctx context.Context, in *EmptyGrpcMessage, opts ...grpc.CallOption) (*TokenExchangeKeySetResponse, error)
 }
 
 type tokenServiceClient struct {
@@ -49,6 +68,15 @@ func (c *tokenServiceClient) AcceptLogin(ctx context.Context, in *AcceptLoginReq
 	return out, nil
 }
 
+func (c *tokenServiceClient) GetLoginRequest(ctx context.Context, in *GetLoginRequestRequest, opts ...grpc.CallOption) (*GetLoginRequestResponse, error) {
+	out := new(GetLoginRequestResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/GetLoginRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tokenServiceClient) AcceptConsent(ctx context.Context, in *AcceptConsentRequest, opts ...grpc.CallOption) (*AcceptConsentResponse, error) {
 	out := new(AcceptConsentResponse)
 	err := c.cc.Invoke(ctx, "/TokenService/AcceptConsent", in, out, opts...)
@@ -58,6 +86,24 @@ func (c *tokenServiceClient) AcceptConsent(ctx context.Context, in *AcceptConsen
 	return out, nil
 }
 
+func (c *tokenServiceClient) GetConsent(ctx context.Context, in *GetConsentRequest, opts ...grpc.CallOption) (*GetConsentResponse, error) {
+	out := new(GetConsentResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/GetConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) RejectConsent(ctx context.Context, in *RejectConsentRequest, opts ...grpc.CallOption) (*RejectConsentResponse, error) {
+	out := new(RejectConsentResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/RejectConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tokenServiceClient) ExchangeToken(ctx context.Context, in *TokenExchangeRequest, opts ...grpc.CallOption) (*TokenExchangeResponse, error) {
 	out := new(TokenExchangeResponse)
 	err := c.cc.Invoke(ctx, "/TokenService/ExchangeToken", in, out, opts...)
@@ -112,18 +158,181 @@ func (c *tokenServiceClient) RevokeAccessToken(ctx context.Context, in *RevokeAc
 	return out, nil
 }
 
+func (c *tokenServiceClient) RevokeLoginSessions(ctx context.Context, in *RevokeLoginSessionsRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error) {
+	out := new(EmptyGrpcMessage)
+	err := c.cc.Invoke(ctx, "/TokenService/RevokeLoginSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error) {
+	out := new(EmptyGrpcMessage)
+	err := c.cc.Invoke(ctx, "/TokenService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error) {
+	out := new(EmptyGrpcMessage)
+	err := c.cc.Invoke(ctx, "/TokenService/RevokeAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) ListConsentSessions(ctx context.Context, in *ListConsentSessionsRequest, opts ...grpc.CallOption) (*ListConsentSessionsResponse, error) {
+	out := new(ListConsentSessionsResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/ListConsentSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) CreateClient(ctx context.Context, in *CreateClientRequest, opts ...grpc.CallOption) (*ClientResponse, error) {
+	out := new(ClientResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/CreateClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) UpdateClient(ctx context.Context, in *UpdateClientRequest, opts ...grpc.CallOption) (*ClientResponse, error) {
+	out := new(ClientResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/UpdateClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) RotateClientSecret(ctx context.Context, in *RotateClientSecretRequest, opts ...grpc.CallOption) (*ClientResponse, error) {
+	out := new(ClientResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/RotateClientSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) DeleteClient(ctx context.Context, in *DeleteClientRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error) {
+	out := new(EmptyGrpcMessage)
+	err := c.cc.Invoke(ctx, "/TokenService/DeleteClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) IssueInitialAccessToken(ctx context.Context, in *IssueInitialAccessTokenRequest, opts ...grpc.CallOption) (*InitialAccessTokenResponse, error) {
+	out := new(InitialAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/IssueInitialAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) RegisterClient(ctx context.Context, in *RegisterClientRequest, opts ...grpc.CallOption) (*RegisteredClientResponse, error) {
+	out := new(RegisteredClientResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/RegisterClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) GetRegisteredClient(ctx context.Context, in *RegisteredClientRequest, opts ...grpc.CallOption) (*RegisteredClientResponse, error) {
+	out := new(RegisteredClientResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/GetRegisteredClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) UpdateRegisteredClient(ctx context.Context, in *UpdateRegisteredClientRequest, opts ...grpc.CallOption) (*RegisteredClientResponse, error) {
+	out := new(RegisteredClientResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/UpdateRegisteredClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) DeleteRegisteredClient(ctx context.Context, in *RegisteredClientRequest, opts ...grpc.CallOption) (*EmptyGrpcMessage, error) {
+	out := new(EmptyGrpcMessage)
+	err := c.cc.Invoke(ctx, "/TokenService/DeleteRegisteredClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) ExchangeForAudience(ctx context.Context, in *ExchangeForAudienceRequest, opts ...grpc.CallOption) (*ExchangeForAudienceResponse, error) {
+	out := new(ExchangeForAudienceResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/ExchangeForAudience", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) GetTokenExchangeKeySet(ctx context.Context, in *EmptyGrpcMessage, opts ...grpc.CallOption) (*TokenExchangeKeySetResponse, error) {
+	out := new(TokenExchangeKeySetResponse)
+	err := c.cc.Invoke(ctx, "/TokenService/GetTokenExchangeKeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TokenServiceServer is the server API for TokenService service.
 // All implementations should embed UnimplementedTokenServiceServer
 // for forward compatibility
 type TokenServiceServer interface {
 	AcceptLogin(context.Context, *AcceptLoginRequest) (*AcceptLoginResponse, error)
+	GetLoginRequest(context.Context, *GetLoginRequestRequest) (*GetLoginRequestResponse, error)
 	AcceptConsent(context.Context, *AcceptConsentRequest) (*AcceptConsentResponse, error)
+	GetConsent(context.Context, *GetConsentRequest) (*GetConsentResponse, error)
+	RejectConsent(context.Context, *RejectConsentRequest) (*RejectConsentResponse, error)
 	ExchangeToken(context.Context, *TokenExchangeRequest) (*TokenExchangeResponse, error)
 	Introspect(context.Context, *IntrospectRequest) (*IntrospectResponse, error)
 	GenerateVerificationToken(context.Context, *GenerateVerificationTokenRequest) (*ClientTokenResponse, error)
 	IntrospectVerificationToken(context.Context, *IntrospectVerificationRequest) (*IntrospectVerificationResponse, error)
 	GenerateRefreshToken(context.Context, *GenerateRefreshTokenRequest) (*TokenExchangeResponse, error)
 	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*EmptyGrpcMessage, error)
+	RevokeLoginSessions(context.Context, *RevokeLoginSessionsRequest) (*EmptyGrpcMessage, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*EmptyGrpcMessage, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*EmptyGrpcMessage, error)
+	ListConsentSessions(context.Context, *ListConsentSessionsRequest) (*ListConsentSessionsResponse, error)
+	CreateClient(context.Context, *CreateClientRequest) (*ClientResponse, error)
+	UpdateClient(context.Context, *UpdateClientRequest) (*ClientResponse, error)
+	RotateClientSecret(context.Context, *RotateClientSecretRequest) (*ClientResponse, error)
+	DeleteClient(context.Context, *DeleteClientRequest) (*EmptyGrpcMessage, error)
+	IssueInitialAccessToken(context.Context, *IssueInitialAccessTokenRequest) (*InitialAccessTokenResponse, error)
+	RegisterClient(context.Context, *RegisterClientRequest) (*RegisteredClientResponse, error)
+	GetRegisteredClient(context.Context, *RegisteredClientRequest) (*RegisteredClientResponse, error)
+	UpdateRegisteredClient(context.Context, *UpdateRegisteredClientRequest) (*RegisteredClientResponse, error)
+	DeleteRegisteredClient(context.Context, *RegisteredClientRequest) (*EmptyGrpcMessage, error)
+	ExchangeForAudience(context.Context, *ExchangeForAudienceRequest) (*ExchangeForAudienceResponse, error)
+	GetTokenExchangeKeySet(context.Context, *EmptyGrpcMessage) (*TokenExchangeKeySetResponse, error)
 }
 
 // UnimplementedTokenServiceServer should be embedded to have forward compatible implementations.
@@ -133,9 +342,18 @@ type UnimplementedTokenServiceServer struct {
 func (UnimplementedTokenServiceServer) AcceptLogin(context.Context, *AcceptLoginRequest) (*AcceptLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptLogin not implemented")
 }
+func (UnimplementedTokenServiceServer) GetLoginRequest(context.Context, *GetLoginRequestRequest) (*GetLoginRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginRequest not implemented")
+}
 func (UnimplementedTokenServiceServer) AcceptConsent(context.Context, *AcceptConsentRequest) (*AcceptConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptConsent not implemented")
 }
+func (UnimplementedTokenServiceServer) GetConsent(context.Context, *GetConsentRequest) (*GetConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsent not implemented")
+}
+func (UnimplementedTokenServiceServer) RejectConsent(context.Context, *RejectConsentRequest) (*RejectConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectConsent not implemented")
+}
 func (UnimplementedTokenServiceServer) ExchangeToken(context.Context, *TokenExchangeRequest) (*TokenExchangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeToken not implemented")
 }
@@ -154,6 +372,54 @@ func (UnimplementedTokenServiceServer) GenerateRefreshToken(context.Context, *Ge
 func (UnimplementedTokenServiceServer) RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*EmptyGrpcMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
 }
+func (UnimplementedTokenServiceServer) RevokeLoginSessions(context.Context, *RevokeLoginSessionsRequest) (*EmptyGrpcMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeLoginSessions not implemented")
+}
+func (UnimplementedTokenServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedTokenServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*EmptyGrpcMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedTokenServiceServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*EmptyGrpcMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (UnimplementedTokenServiceServer) ListConsentSessions(context.Context, *ListConsentSessionsRequest) (*ListConsentSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsentSessions not implemented")
+}
+func (UnimplementedTokenServiceServer) CreateClient(context.Context, *CreateClientRequest) (*ClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClient not implemented")
+}
+func (UnimplementedTokenServiceServer) UpdateClient(context.Context, *UpdateClientRequest) (*ClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClient not implemented")
+}
+func (UnimplementedTokenServiceServer) RotateClientSecret(context.Context, *RotateClientSecretRequest) (*ClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateClientSecret not implemented")
+}
+func (UnimplementedTokenServiceServer) DeleteClient(context.Context, *DeleteClientRequest) (*EmptyGrpcMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClient not implemented")
+}
+func (UnimplementedTokenServiceServer) IssueInitialAccessToken(context.Context, *IssueInitialAccessTokenRequest) (*InitialAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueInitialAccessToken not implemented")
+}
+func (UnimplementedTokenServiceServer) RegisterClient(context.Context, *RegisterClientRequest) (*RegisteredClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterClient not implemented")
+}
+func (UnimplementedTokenServiceServer) GetRegisteredClient(context.Context, *RegisteredClientRequest) (*RegisteredClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegisteredClient not implemented")
+}
+func (UnimplementedTokenServiceServer) UpdateRegisteredClient(context.Context, *UpdateRegisteredClientRequest) (*RegisteredClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRegisteredClient not implemented")
+}
+func (UnimplementedTokenServiceServer) DeleteRegisteredClient(context.Context, *RegisteredClientRequest) (*EmptyGrpcMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRegisteredClient not implemented")
+}
+func (UnimplementedTokenServiceServer) ExchangeForAudience(context.Context, *ExchangeForAudienceRequest) (*ExchangeForAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeForAudience not implemented")
+}
+func (UnimplementedTokenServiceServer) GetTokenExchangeKeySet(context.Context, *EmptyGrpcMessage) (*TokenExchangeKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenExchangeKeySet not implemented")
+}
 
 // UnsafeTokenServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TokenServiceServer will
@@ -184,6 +450,24 @@ func _TokenService_AcceptLogin_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenService_GetLoginRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).GetLoginRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/GetLoginRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).GetLoginRequest(ctx, req.(*GetLoginRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TokenService_AcceptConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AcceptConsentRequest)
 	if err := dec(in); err != nil {
@@ -202,6 +486,42 @@ func _TokenService_AcceptConsent_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenService_GetConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).GetConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/GetConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).GetConsent(ctx, req.(*GetConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_RejectConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).RejectConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/RejectConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).RejectConsent(ctx, req.(*RejectConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TokenService_ExchangeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TokenExchangeRequest)
 	if err := dec(in); err != nil {
@@ -310,6 +630,294 @@ func _TokenService_RevokeAccessToken_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenService_RevokeLoginSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLoginSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).RevokeLoginSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/RevokeLoginSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).RevokeLoginSessions(ctx, req.(*RevokeLoginSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/RevokeAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_ListConsentSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsentSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).ListConsentSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/ListConsentSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).ListConsentSessions(ctx, req.(*ListConsentSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).CreateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/CreateClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).CreateClient(ctx, req.(*CreateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_UpdateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).UpdateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/UpdateClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).UpdateClient(ctx, req.(*UpdateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_RotateClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateClientSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).RotateClientSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/RotateClientSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).RotateClientSecret(ctx, req.(*RotateClientSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_DeleteClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).DeleteClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/DeleteClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).DeleteClient(ctx, req.(*DeleteClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_IssueInitialAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueInitialAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).IssueInitialAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/IssueInitialAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).IssueInitialAccessToken(ctx, req.(*IssueInitialAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_RegisterClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).RegisterClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/RegisterClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).RegisterClient(ctx, req.(*RegisterClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_GetRegisteredClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisteredClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).GetRegisteredClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/GetRegisteredClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).GetRegisteredClient(ctx, req.(*RegisteredClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_UpdateRegisteredClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRegisteredClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).UpdateRegisteredClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/UpdateRegisteredClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).UpdateRegisteredClient(ctx, req.(*UpdateRegisteredClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_DeleteRegisteredClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisteredClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).DeleteRegisteredClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/DeleteRegisteredClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).DeleteRegisteredClient(ctx, req.(*RegisteredClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_ExchangeForAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeForAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).ExchangeForAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/ExchangeForAudience",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).ExchangeForAudience(ctx, req.(*ExchangeForAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_GetTokenExchangeKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyGrpcMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).GetTokenExchangeKeySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TokenService/GetTokenExchangeKeySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).GetTokenExchangeKeySet(ctx, req.(*EmptyGrpcMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TokenService_ServiceDesc is the grpc.ServiceDesc for TokenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,10 +929,22 @@ var TokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptLogin",
 			Handler:    _TokenService_AcceptLogin_Handler,
 		},
+		{
+			MethodName: "GetLoginRequest",
+			Handler:    _TokenService_GetLoginRequest_Handler,
+		},
 		{
 			MethodName: "AcceptConsent",
 			Handler:    _TokenService_AcceptConsent_Handler,
 		},
+		{
+			MethodName: "GetConsent",
+			Handler:    _TokenService_GetConsent_Handler,
+		},
+		{
+			MethodName: "RejectConsent",
+			Handler:    _TokenService_RejectConsent_Handler,
+		},
 		{
 			MethodName: "ExchangeToken",
 			Handler:    _TokenService_ExchangeToken_Handler,
@@ -349,6 +969,70 @@ var TokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAccessToken",
 			Handler:    _TokenService_RevokeAccessToken_Handler,
 		},
+		{
+			MethodName: "RevokeLoginSessions",
+			Handler:    _TokenService_RevokeLoginSessions_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _TokenService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _TokenService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _TokenService_RevokeAllSessions_Handler,
+		},
+		{
+			MethodName: "ListConsentSessions",
+			Handler:    _TokenService_ListConsentSessions_Handler,
+		},
+		{
+			MethodName: "CreateClient",
+			Handler:    _TokenService_CreateClient_Handler,
+		},
+		{
+			MethodName: "UpdateClient",
+			Handler:    _TokenService_UpdateClient_Handler,
+		},
+		{
+			MethodName: "RotateClientSecret",
+			Handler:    _TokenService_RotateClientSecret_Handler,
+		},
+		{
+			MethodName: "DeleteClient",
+			Handler:    _TokenService_DeleteClient_Handler,
+		},
+		{
+			MethodName: "IssueInitialAccessToken",
+			Handler:    _TokenService_IssueInitialAccessToken_Handler,
+		},
+		{
+			MethodName: "RegisterClient",
+			Handler:    _TokenService_RegisterClient_Handler,
+		},
+		{
+			MethodName: "GetRegisteredClient",
+			Handler:    _TokenService_GetRegisteredClient_Handler,
+		},
+		{
+			MethodName: "UpdateRegisteredClient",
+			Handler:    _TokenService_UpdateRegisteredClient_Handler,
+		},
+		{
+			MethodName: "DeleteRegisteredClient",
+			Handler:    _TokenService_DeleteRegisteredClient_Handler,
+		},
+		{
+			MethodName: "ExchangeForAudience",
+			Handler:    _TokenService_ExchangeForAudience_Handler,
+		},
+		{
+			MethodName: "GetTokenExchangeKeySet",
+			Handler:    _TokenService_GetTokenExchangeKeySet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/python/pyproto/tokenservice.proto",
@@ -6,24 +6,64 @@ import (
 	"html/template"
 	"log"
 	"os"
+	"time"
 )
 
 var (
 	templateMapping = map[EventType]string{
-		VerificationEvent: "templates/verify_email.html",
+		VerificationEvent:  "templates/verify_email.html",
+		ResetPasswordEvent: "templates/reset_password.html",
+		SecurityAlertEvent: "templates/security_alert.html",
+		LoginCodeEvent:     "templates/login_code.html",
+	}
+)
+
+var (
+	securityAlertMessages = map[string]string{
+		"refresh_token_reuse": "A sign-in session on your account was used in a way that suggests it was copied to another device, so we signed it out.",
+		"account_lockout":     "There were several failed attempts to sign in to your account, so we have temporarily locked it.",
+		"email_change":        "A change of the email address of your account was requested. It will only change once the new address is verified.",
 	}
 )
 
 type EventType string
 
 const (
-	VerificationEvent EventType = "VerificationEvent"
+	VerificationEvent  EventType = "VerificationEvent"
+	ResetPasswordEvent EventType = "ResetPasswordEvent"
+	SecurityAlertEvent EventType = "SecurityAlertEvent"
+	LoginCodeEvent     EventType = "LoginCodeEvent"
 )
 
 type VerificationPayload struct {
 	VerificationID string `json:"verificationId"`
 }
 
+type ResetPasswordPayload struct {
+	ResetToken string `json:"resetToken"`
+}
+
+type SecurityAlertPayload struct {
+	Reason     string    `json:"reason"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// Message returns a human readable description of the security alert reason.
+func (p SecurityAlertPayload) Message() string {
+	if message, ok := securityAlertMessages[p.Reason]; ok {
+		return message
+	}
+	return "We noticed unusual activity on your account."
+}
+
+// LoginCodePayload carries the one-time code of a passwordless login.
+type LoginCodePayload struct {
+	Code             string `json:"code"`
+	ExpiresInMinutes int    `json:"expiresInMinutes"`
+}
+
 type Event struct {
 	Email        string
 	Type         EventType
@@ -34,6 +74,12 @@ func (e *Event) GetSubject() string {
 	switch e.Type {
 	case VerificationEvent:
 		return "Verify your email address"
+	case ResetPasswordEvent:
+		return "Reset your password"
+	case SecurityAlertEvent:
+		return "Security alert for your account"
+	case LoginCodeEvent:
+		return "Your login code"
 	}
 	return ""
 }
@@ -42,6 +88,12 @@ func (e *Event) GetEventData() interface{} {
 	switch e.Type {
 	case VerificationEvent:
 		return VerificationPayload{}
+	case ResetPasswordEvent:
+		return ResetPasswordPayload{}
+	case SecurityAlertEvent:
+		return SecurityAlertPayload{}
+	case LoginCodeEvent:
+		return LoginCodePayload{}
 	}
 	return nil
 }
@@ -55,6 +107,24 @@ func (e *Event) GetTemplate() (string, error) {
 			return "", err
 		}
 		data = verificationPayload
+	case ResetPasswordEvent:
+		var resetPasswordPayload ResetPasswordPayload
+		if err := json.Unmarshal(e.EventPayload, &resetPasswordPayload); err != nil {
+			return "", err
+		}
+		data = resetPasswordPayload
+	case SecurityAlertEvent:
+		var securityAlertPayload SecurityAlertPayload
+		if err := json.Unmarshal(e.EventPayload, &securityAlertPayload); err != nil {
+			return "", err
+		}
+		data = securityAlertPayload
+	case LoginCodeEvent:
+		var loginCodePayload LoginCodePayload
+		if err := json.Unmarshal(e.EventPayload, &loginCodePayload); err != nil {
+			return "", err
+		}
+		data = loginCodePayload
 	}
 
 	contentBuffer := new(bytes.Buffer)
@@ -6,6 +6,10 @@ type ContextKey string
 const (
 	// UserContext is a gin context key in which the user information stored on gin.Context.
 	UserContext = "userProfile"
+	// SessionContext is a gin context key in which the authenticated session is stored on gin.Context.
+	SessionContext = "session"
+	// IntrospectionContext is a gin context key in which the granted scopes and audience of the access token are stored.
+	IntrospectionContext = "introspection"
 )
 
 const (
@@ -38,3 +42,38 @@ const (
 	// Error is a string constant to be used in error level logs as a key.
 	Error = "error"
 )
+
+const (
+	// ErrorDomain is the domain of the gRPC error details returned by the services.
+	ErrorDomain = "cisauth"
+	// SessionCompromised is the gRPC error reason returned when a session is revoked because a rotated-out token was reused.
+	SessionCompromised = "SESSION_COMPROMISED"
+	// InvalidRedirectURI is the gRPC error reason returned when a redirect uri of a client registration is not allowed.
+	InvalidRedirectURI = "INVALID_REDIRECT_URI"
+	// InvalidClientMetadata is the gRPC error reason returned when the metadata of a client registration is not allowed.
+	InvalidClientMetadata = "INVALID_CLIENT_METADATA"
+)
+
+// Authentication context class references sent to the oauth2 server on login accept.
+const (
+	// ACRSingleFactor is the acr of a login verified with a single factor.
+	ACRSingleFactor = "1"
+	// ACRMultiFactor is the acr of a login verified with more than one factor.
+	ACRMultiFactor = "2"
+)
+
+// Authentication method references as defined in RFC 8176.
+const (
+	// AMRPassword is a password based authentication.
+	AMRPassword = "pwd"
+	// AMROneTimePassword is a one-time password based authentication.
+	AMROneTimePassword = "otp"
+	// AMRMultiFactor is an authentication with more than one factor.
+	AMRMultiFactor = "mfa"
+	// AMRHardwareKey is a proof-of-possession of a hardware-secured key.
+	AMRHardwareKey = "hwk"
+	// AMRSoftwareKey is a proof-of-possession of a software-secured key.
+	AMRSoftwareKey = "swk"
+	// AMRFederated is a login at an upstream identity provider, it is not registered by RFC 8176.
+	AMRFederated = "fed"
+)
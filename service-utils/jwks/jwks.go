@@ -0,0 +1,276 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+const (
+	// defaultTTL is how long a fetched key set is used before it is fetched again.
+	defaultTTL = time.Hour
+	// defaultMinRefreshInterval limits how often an unknown kid can trigger a fetch.
+	defaultMinRefreshInterval = 30 * time.Second
+)
+
+var (
+	// ErrKeyNotFound when no key with the requested kid exists in the key set.
+	ErrKeyNotFound = errors.New("signing key not found in key set")
+	// ErrMissingKeyID when the token header has no kid.
+	ErrMissingKeyID = errors.New("token header has no kid")
+)
+
+// JSONWebKey is a public JSON Web Key as defined in RFC 7517, limited to RSA and EC keys.
+type JSONWebKey struct {
+	KeyID     string `json:"kid"`
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+}
+
+// KeySet is a JSON Web Key Set.
+type KeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// Cache keeps the public keys of a remote JSON Web Key Set in memory. Keys are fetched again
+// once the TTL passes or when a token is signed with a kid which is not known yet,
+// so key rotation on the authorization server is picked up without a restart.
+type Cache struct {
+	httpClient         *http.Client
+	url                string
+	ttl                time.Duration
+	minRefreshInterval time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewCache creates a key set cache for the given JWKS endpoint.
+func NewCache(httpClient *http.Client, url string) *Cache {
+	return &Cache{
+		httpClient:         httpClient,
+		url:                url,
+		ttl:                defaultTTL,
+		minRefreshInterval: defaultMinRefreshInterval,
+		keys:               map[string]crypto.PublicKey{},
+	}
+}
+
+// Keyfunc is a jwt.Keyfunc resolving the verification key from the kid of the token header.
+func (c *Cache) Keyfunc(token *jwt.Token) (interface{}, error) {
+	return c.KeyfuncContext(context.Background())(token)
+}
+
+// KeyfuncContext returns a jwt.Keyfunc like Keyfunc which fetches the key set with the context of the request.
+func (c *Cache) KeyfuncContext(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok || len(kid) == 0 {
+			return nil, ErrMissingKeyID
+		}
+
+		return c.Key(ctx, kid)
+	}
+}
+
+// Key returns the public key for the kid, fetching the key set when it is stale or the kid is unknown.
+func (c *Cache) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fresh := time.Since(c.fetchedAt) < c.ttl
+	canRefresh := time.Since(c.fetchedAt) >= c.minRefreshInterval
+	c.mu.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+	if !ok && !canRefresh {
+		return nil, ErrKeyNotFound
+	}
+
+	if err := c.Refresh(ctx); err != nil {
+		// serve the last known key when the endpoint is temporarily unavailable.
+		if ok {
+			slog.ErrorContext(ctx, "unable to refresh key set, using cached key", slog.Any(constants.Error, err))
+			return key, nil
+		}
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if key, ok = c.keys[kid]; !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return key, nil
+}
+
+// Refresh fetches the key set and replaces the cached keys.
+func (c *Cache) Refresh(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	slog.InfoContext(ctx, "fetching json web key set", slog.String("url", c.url))
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch json web key set", slog.Any(constants.Error, err))
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		slog.ErrorContext(ctx, "unexpected status code returned for json web key set", slog.Int("statusCode", response.StatusCode))
+		return fmt.Errorf("unexpected status code %d fetching key set", response.StatusCode)
+	}
+
+	var keySet KeySet
+	if err := json.NewDecoder(response.Body).Decode(&keySet); err != nil {
+		slog.ErrorContext(ctx, "unable to decode json web key set", slog.Any(constants.Error, err))
+		return err
+	}
+
+	keys := ParseKeySet(ctx, keySet)
+
+	c.mu.Lock()
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+
+	slog.InfoContext(ctx, "successfully refreshed json web key set", slog.Int("keys", len(keys)))
+
+	return nil
+}
+
+// ParseKeySet converts the signing keys of a key set into public keys by kid,
+// keys which are not supported are skipped.
+func ParseKeySet(ctx context.Context, keySet KeySet) map[string]crypto.PublicKey {
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if len(jwk.Use) != 0 && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			slog.ErrorContext(ctx, "skipping unsupported json web key", slog.String("kid", jwk.KeyID), slog.Any(constants.Error, err))
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	return keys
+}
+
+// PublicKey converts the JSON Web Key into an *rsa.PublicKey or *ecdsa.PublicKey.
+func (k JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+}
+
+// NewJSONWebKey converts an *rsa.PublicKey or *ecdsa.PublicKey into the JSON Web Key of a signing key published
+// under the kid, RSA keys are used with RS256 and EC keys with the ES algorithm of their curve.
+func NewJSONWebKey(kid string, key crypto.PublicKey) (JSONWebKey, error) {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return JSONWebKey{
+			KeyID:     kid,
+			KeyType:   "RSA",
+			Use:       "sig",
+			Algorithm: jwt.SigningMethodRS256.Alg(),
+			N:         base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		var algorithm string
+		switch key.Curve {
+		case elliptic.P256():
+			algorithm = jwt.SigningMethodES256.Alg()
+		case elliptic.P384():
+			algorithm = jwt.SigningMethodES384.Alg()
+		case elliptic.P521():
+			algorithm = jwt.SigningMethodES512.Alg()
+		default:
+			return JSONWebKey{}, errors.New("unsupported curve")
+		}
+		// coordinates are padded to the size of the curve as required by RFC 7518.
+		size := (key.Curve.Params().BitSize + 7) / 8
+		return JSONWebKey{
+			KeyID:     kid,
+			KeyType:   "EC",
+			Use:       "sig",
+			Algorithm: algorithm,
+			Curve:     key.Curve.Params().Name,
+			X:         base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+			Y:         base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+		}, nil
+	}
+
+	return JSONWebKey{}, fmt.Errorf("unsupported key type %T", key)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(decoded), nil
+}
@@ -0,0 +1,166 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func rsaJWK(kid string, key *rsa.PublicKey) JSONWebKey {
+	return JSONWebKey{
+		KeyID:   kid,
+		KeyType: "RSA",
+		Use:     "sig",
+		N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) JSONWebKey {
+	return JSONWebKey{
+		KeyID:   kid,
+		KeyType: "EC",
+		Curve:   "P-256",
+		X:       base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+		Y:       base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+	}
+}
+
+func TestCacheVerifiesAndRotatesKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetches atomic.Int32
+	keySet := KeySet{Keys: []JSONWebKey{rsaJWK("rsa-1", &rsaKey.PublicKey)}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_ = json.NewEncoder(w).Encode(keySet)
+	}))
+	defer server.Close()
+
+	cache := NewCache(server.Client(), server.URL)
+	cache.minRefreshInterval = 0
+
+	claims := jwt.MapClaims{"sub": "user", "exp": time.Now().Add(time.Minute).Unix()}
+	rsaToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	rsaToken.Header["kid"] = "rsa-1"
+	signed, err := rsaToken.SignedString(rsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := jwt.Parse(signed, cache.Keyfunc); err != nil {
+			t.Fatalf("expected rs256 token to verify, got %v", err)
+		}
+	}
+	if fetches.Load() != 1 {
+		t.Fatalf("expected key set to be fetched once, got %d", fetches.Load())
+	}
+
+	// rotate in an EC key, the unknown kid must trigger a refetch.
+	keySet.Keys = append(keySet.Keys, ecJWK("ec-1", &ecKey.PublicKey))
+	ecToken := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	ecToken.Header["kid"] = "ec-1"
+	signed, err = ecToken.SignedString(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwt.Parse(signed, cache.Keyfunc); err != nil {
+		t.Fatalf("expected es256 token to verify after rotation, got %v", err)
+	}
+	if fetches.Load() != 2 {
+		t.Fatalf("expected key set to be fetched again, got %d", fetches.Load())
+	}
+
+	// a token signed by a key which is not in the set must fail.
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	forged := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	forged.Header["kid"] = "rsa-1"
+	signed, _ = forged.SignedString(otherKey)
+	if _, err := jwt.Parse(signed, cache.Keyfunc); err == nil {
+		t.Fatal("expected token signed by unknown key to fail verification")
+	}
+}
+
+func TestKeyfuncContextFetchesWithTheRequestContext(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(KeySet{Keys: []JSONWebKey{rsaJWK("rsa-1", &rsaKey.PublicKey)}})
+	}))
+	defer server.Close()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"sub": "user", "exp": time.Now().Add(time.Minute).Unix()})
+	token.Header["kid"] = "rsa-1"
+	signed, err := token.SignedString(rsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := NewCache(server.Client(), server.URL)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := jwt.Parse(signed, cache.KeyfuncContext(canceled)); err == nil {
+		t.Fatal("expected the key set not to be fetched with a canceled request context")
+	}
+
+	cache = NewCache(server.Client(), server.URL)
+	if _, err := jwt.Parse(signed, cache.KeyfuncContext(context.Background())); err != nil {
+		t.Fatalf("expected rs256 token to verify, got %v", err)
+	}
+}
+
+func TestNewJSONWebKeyRoundTrips(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsaWebKey, err := NewJSONWebKey("rsa-1", &rsaKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecWebKey, err := NewJSONWebKey("ec-1", &ecKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsaWebKey.Algorithm != "RS256" || ecWebKey.Algorithm != "ES256" || ecWebKey.Curve != "P-256" {
+		t.Fatalf("expected the algorithms of the keys, got %q and %q", rsaWebKey.Algorithm, ecWebKey.Algorithm)
+	}
+	if len(ecWebKey.X) != 43 || len(ecWebKey.Y) != 43 {
+		t.Fatal("expected the ec coordinates to be padded to the curve size")
+	}
+
+	keys := ParseKeySet(context.Background(), KeySet{Keys: []JSONWebKey{rsaWebKey, ecWebKey}})
+	if parsed, ok := keys["rsa-1"].(*rsa.PublicKey); !ok || !parsed.Equal(&rsaKey.PublicKey) {
+		t.Fatal("expected the rsa key to round trip")
+	}
+	if parsed, ok := keys["ec-1"].(*ecdsa.PublicKey); !ok || !parsed.Equal(&ecKey.PublicKey) {
+		t.Fatal("expected the ec key to round trip")
+	}
+}
@@ -8,11 +8,14 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
 
@@ -23,7 +26,33 @@ import (
 const nullString = ""
 
 type TokenMiddleware struct {
-	client pb.TokenServiceClient
+	client         pb.TokenServiceClient
+	localValidator *localValidator
+	localConfig    *LocalValidationConfig
+}
+
+// Option configures the token middleware.
+type Option func(t *TokenMiddleware)
+
+// WithTokenServiceClient uses the given client instead of dialing the token service url.
+func WithTokenServiceClient(client pb.TokenServiceClient) Option {
+	return func(t *TokenMiddleware) {
+		t.client = client
+	}
+}
+
+// WithLocalValidation validates JWT access tokens locally against the JWKS of the oauth2 server
+// and only introspects tokens through the token service when they are near expiry or expired, so that they get
+// refreshed. Tokens of another issuer or audience, with an invalid signature or without the required scopes are
+// rejected without introspection.
+// Access tokens of the trusted issuers of the config are only validated locally.
+// The session of a locally validated request is taken from the request as is, the token service confirms
+// it belongs to the access token before revoking it. Logout, session revocation and account locks only
+// take effect once the access token is introspected again, so keep access tokens short-lived.
+func WithLocalValidation(config LocalValidationConfig) Option {
+	return func(t *TokenMiddleware) {
+		t.localConfig = &config
+	}
 }
 
 // Middleware abstraction for token introspection middleware.
@@ -76,6 +105,39 @@ func (t *TokenMiddleware) DoAuthenticate(c *gin.Context) {
 		return
 	}
 
+	if t.localValidator != nil {
+		claims, err := t.localValidator.validate(ctx, token)
+		if err == nil && claims.Ext.ID != nil {
+			setAuthenticatedContext(c, claims.Ext, models.Session{
+				ID:          sessionID,
+				AccessToken: token,
+				ClientID:    claims.ClientID,
+			}, models.Introspection{
+				ClientID:  claims.ClientID,
+				Subject:   claims.Subject,
+				Scopes:    claims.Scope,
+				Audience:  claims.Audience,
+				ExpiresAt: claims.ExpiresAt.Time,
+				Actor:     claims.Act.Sub,
+			})
+			return
+		}
+		if errors.Is(err, errMissingScope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"message": "insufficient scope",
+			})
+			return
+		}
+		if err != nil && !requiresIntrospection(err) {
+			slog.ErrorContext(ctx, "unable to validate access token locally", slog.Any("error", err))
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"message": "missing/invalid authentication headers",
+			})
+			return
+		}
+		slog.InfoContext(ctx, "falling back to token introspection", slog.Any("error", err))
+	}
+
 	// This is mandatory for all gRPC requests.
 	// According to microsoft doc.
 	// Every request should be triggered from the dependency request operationID as operationParentID.
@@ -90,6 +152,14 @@ func (t *TokenMiddleware) DoAuthenticate(c *gin.Context) {
 
 	if err != nil {
 		slog.ErrorContext(ctx, "unable to introspect access token with provided session id", slog.Any("error", err))
+		if isSessionCompromised(err) {
+			c.Writer.Header().Add("set-cookie", "access_token=; Path=/; Max-Age=-1")
+			c.Writer.Header().Add("set-cookie", "session=; Path=/; Max-Age=-1")
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"message": "session has been revoked, please login again",
+			})
+			return
+		}
 		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
 			"message": "missing/invalid authentication headers",
 		})
@@ -102,6 +172,7 @@ func (t *TokenMiddleware) DoAuthenticate(c *gin.Context) {
 		// these logs will help us find how frequent users are using the application and continuous engagement.
 		slog.ErrorContext(ctx, "access token refreshed, setting new token in cookie")
 		c.Writer.Header().Add("set-cookie", fmt.Sprintf("access_token=%s; Path=/; Max-Age=%d", introspect.NewAccessToken, introspect.NewAccessTokenExpiry))
+		token = introspect.NewAccessToken
 	}
 
 	if introspect.IDToken == nil {
@@ -121,20 +192,76 @@ func (t *TokenMiddleware) DoAuthenticate(c *gin.Context) {
 		})
 		return
 	}
-	c.Set(constants.UserContext, models.UserProfile{
-		ID:    &parsedTokenID,
-		Email: introspect.IDToken.UserProfile.Email,
-		Name:  introspect.IDToken.UserProfile.Name,
+	setAuthenticatedContext(c, models.UserProfile{
+		ID:          &parsedTokenID,
+		Email:       introspect.IDToken.UserProfile.Email,
+		Name:        introspect.IDToken.UserProfile.Name,
+		Roles:       introspect.IDToken.UserProfile.Roles,
+		Permissions: introspect.IDToken.UserProfile.Permissions,
+	}, models.Session{
+		ID:          sessionID,
+		AccessToken: token,
+		ClientID:    introspect.ClientID,
+	}, models.Introspection{
+		ClientID:  introspect.ClientID,
+		Subject:   introspect.Subject,
+		Scopes:    strings.Fields(introspect.Scope),
+		Audience:  introspect.Audience,
+		ExpiresAt: time.Unix(introspect.Expiry, 0),
 	})
+}
+
+// setAuthenticatedContext stores the authenticated user, session and token grant on the context and continues the chain.
+func setAuthenticatedContext(c *gin.Context, profile models.UserProfile, session models.Session, introspection models.Introspection) {
+	c.Set(constants.UserContext, profile)
+	c.Set(constants.SessionContext, session)
+	c.Set(constants.IntrospectionContext, introspection)
 	c.Next()
 }
 
+// isSessionCompromised reports whether the token service revoked the session due to token reuse.
+func isSessionCompromised(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == constants.SessionCompromised {
+			return true
+		}
+	}
+	return false
+}
+
 // NewTokenMiddleware returns token middleware instance with token client connection, ensure
-// TOKEN_SERVICE_URL env variable is set before calling this function.
+// TOKEN_SERVICE_URL env variable is set before calling this function unless a client is given.
+// Tokens are introspected on every request, use NewTokenMiddlewareWithOptions to validate them locally.
 func NewTokenMiddleware(tokenServiceURL string, opts ...pb.TokenServiceClient) (*TokenMiddleware, error) {
+	if len(opts) == 0 {
+		return NewTokenMiddlewareWithOptions(tokenServiceURL)
+	}
+
+	return NewTokenMiddlewareWithOptions(tokenServiceURL, WithTokenServiceClient(opts[0]))
+}
+
+// NewTokenMiddlewareWithOptions returns token middleware instance configured by the options, the token service
+// url is dialed unless a client is given with WithTokenServiceClient. Tokens are introspected on every request
+// unless WithLocalValidation is set.
+func NewTokenMiddlewareWithOptions(tokenServiceURL string, opts ...Option) (*TokenMiddleware, error) {
 	t := &TokenMiddleware{}
+	for _, opt := range opts {
+		opt(t)
+	}
 
-	if len(opts) == 0 {
+	if t.localConfig != nil {
+		validator, err := newLocalValidator(*t.localConfig)
+		if err != nil {
+			return nil, err
+		}
+		t.localValidator = validator
+	}
+
+	if t.client == nil {
 		if len(tokenServiceURL) == 0 {
 			return nil, errors.New("either token service url or token service client is required")
 		}
@@ -146,8 +273,6 @@ func NewTokenMiddleware(tokenServiceURL string, opts ...pb.TokenServiceClient) (
 		}
 
 		t.client = pb.NewTokenServiceClient(tokenServiceConnection)
-	} else {
-		t.client = opts[0]
 	}
 
 	return t, nil
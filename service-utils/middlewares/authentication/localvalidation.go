@@ -0,0 +1,205 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// defaultRefreshWindow is how close to expiry a token is handed over to introspection for a refresh.
+const defaultRefreshWindow = time.Minute
+
+var (
+	// errTokenNearExpiry when the access token has to be introspected so that it gets refreshed.
+	errTokenNearExpiry = errors.New("access token is near expiry")
+	// errMissingScope when the access token was not granted a required scope.
+	errMissingScope = errors.New("access token is missing a required scope")
+	// errUntrustedIssuer when the access token was issued by another authorization server.
+	errUntrustedIssuer = errors.New("access token issued by untrusted issuer")
+	// errInvalidTrustedIssuerToken when the access token of a trusted issuer is invalid, it can't be introspected.
+	errInvalidTrustedIssuerToken = errors.New("invalid access token of trusted issuer")
+)
+
+// LocalValidationConfig configures validation of JWT access tokens against the JWKS of the oauth2 server.
+// Revoked sessions are only noticed once their access token is within the RefreshWindow of expiry,
+// so keep the access token lifetime short when this mode is enabled.
+type LocalValidationConfig struct {
+	// KeySetURL is the JWKS endpoint of the oauth2 server, e.g. http://hydra:4444/.well-known/jwks.json.
+	KeySetURL string
+	// Issuer is the expected iss claim of the access tokens.
+	Issuer string
+	// Audience, when set, must be one of the aud claim values of the access tokens.
+	Audience string
+	// Scopes lists the scopes every access token must be granted.
+	Scopes []string
+	// RefreshWindow is how long before expiry tokens fall back to gRPC introspection, defaults to a minute.
+	RefreshWindow time.Duration
+	// HTTPClient fetches the key set, defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// TrustedIssuers lists further issuers whose access tokens are accepted, e.g. the token exchange of the token
+	// service. Their tokens can't be introspected, so they are only validated locally and must carry the Audience.
+	TrustedIssuers []TrustedIssuer
+}
+
+// TrustedIssuer is an issuer of access tokens besides the oauth2 server.
+type TrustedIssuer struct {
+	// Issuer is the iss claim of its access tokens.
+	Issuer string
+	// KeySetURL is the JWKS endpoint its access tokens are verified with.
+	KeySetURL string
+}
+
+// accessTokenClaims are the claims of a JWT access token issued by the oauth2 server.
+type accessTokenClaims struct {
+	jwt.RegisteredClaims
+	ClientID string             `json:"client_id"`
+	Scope    []string           `json:"scp"`
+	Ext      models.UserProfile `json:"ext"`
+	Act      actorClaim         `json:"act"`
+}
+
+// actorClaim is the service acting on behalf of the user of an exchanged access token (RFC 8693).
+type actorClaim struct {
+	Sub string `json:"sub"`
+}
+
+type localValidator struct {
+	config  LocalValidationConfig
+	keySets map[string]*jwks.Cache
+	parser  *jwt.Parser
+}
+
+func newLocalValidator(config LocalValidationConfig) (*localValidator, error) {
+	if len(config.KeySetURL) == 0 || len(config.Issuer) == 0 {
+		return nil, errors.New("key set url and issuer are required for local token validation")
+	}
+	if len(config.TrustedIssuers) != 0 && len(config.Audience) == 0 {
+		return nil, errors.New("audience is required to accept access tokens of trusted issuers")
+	}
+	if config.RefreshWindow <= 0 {
+		config.RefreshWindow = defaultRefreshWindow
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if len(config.Audience) != 0 {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
+	keySets := map[string]*jwks.Cache{
+		normalizeIssuer(config.Issuer): jwks.NewCache(config.HTTPClient, config.KeySetURL),
+	}
+	for _, trusted := range config.TrustedIssuers {
+		if len(trusted.KeySetURL) == 0 || len(trusted.Issuer) == 0 {
+			return nil, errors.New("key set url and issuer are required for trusted issuers")
+		}
+		keySets[normalizeIssuer(trusted.Issuer)] = jwks.NewCache(config.HTTPClient, trusted.KeySetURL)
+	}
+
+	return &localValidator{
+		config:  config,
+		keySets: keySets,
+		parser:  jwt.NewParser(options...),
+	}, nil
+}
+
+// validate verifies the signature and claims of the access token. Use requiresIntrospection to tell apart tokens
+// which need a refresh through introspection from invalid ones.
+func (v *localValidator) validate(ctx context.Context, token string) (*accessTokenClaims, error) {
+	claims := &accessTokenClaims{}
+	_, err := v.parser.ParseWithClaims(token, claims, v.keyfunc(ctx))
+	trustedIssuer := v.isTrustedIssuer(claims.Issuer)
+	if err == nil {
+		err = v.validateClaims(ctx, claims, trustedIssuer)
+	}
+	if err != nil {
+		if trustedIssuer {
+			return nil, fmt.Errorf("%w: %w", errInvalidTrustedIssuerToken, err)
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "successfully validated access token locally")
+
+	return claims, nil
+}
+
+// keyfunc verifies the access token with the key set of its issuer.
+func (v *localValidator) keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		issuer, _ := token.Claims.GetIssuer()
+		keySet, ok := v.keySets[normalizeIssuer(issuer)]
+		if !ok {
+			slog.ErrorContext(ctx, "access token issued by unexpected issuer", slog.String("issuer", issuer))
+			return nil, errUntrustedIssuer
+		}
+
+		return keySet.KeyfuncContext(ctx)(token)
+	}
+}
+
+// validateClaims checks the scopes of the access token and hands tokens of the oauth2 server near expiry over for a
+// refresh, access tokens of trusted issuers can't be refreshed.
+func (v *localValidator) validateClaims(ctx context.Context, claims *accessTokenClaims, trustedIssuer bool) error {
+	for _, required := range v.config.Scopes {
+		if !containsString(claims.Scope, required) {
+			slog.ErrorContext(ctx, "access token is missing required scope", slog.String("scope", required))
+			return errMissingScope
+		}
+	}
+
+	if !trustedIssuer && time.Until(claims.ExpiresAt.Time) <= v.config.RefreshWindow {
+		return errTokenNearExpiry
+	}
+
+	return nil
+}
+
+// requiresIntrospection reports whether a token of the oauth2 server failed local validation only because it is
+// near expiry or expired, introspection refreshes it. Any other failure rejects the token.
+func requiresIntrospection(err error) bool {
+	if errors.Is(err, errInvalidTrustedIssuerToken) {
+		return false
+	}
+
+	return errors.Is(err, errTokenNearExpiry) || errors.Is(err, jwt.ErrTokenExpired)
+}
+
+// isTrustedIssuer reports whether the issuer is one of the trusted issuers besides the oauth2 server.
+func (v *localValidator) isTrustedIssuer(issuer string) bool {
+	for _, trusted := range v.config.TrustedIssuers {
+		if normalizeIssuer(trusted.Issuer) == normalizeIssuer(issuer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeIssuer(issuer string) string {
+	return strings.TrimSuffix(issuer, "/")
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,263 @@
+package authentication
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+const testIssuer = "http://localhost:4444"
+
+type fakeTokenClient struct {
+	pb.TokenServiceClient
+	userID        string
+	introspection int
+}
+
+func (f *fakeTokenClient) Introspect(context.Context, *pb.IntrospectRequest, ...grpc.CallOption) (*pb.IntrospectResponse, error) {
+	f.introspection++
+	return &pb.IntrospectResponse{
+		Active:   true,
+		ClientID: "client",
+		IDToken:  &pb.IDToken{UserProfile: &pb.UserProfile{ID: f.userID}},
+	}, nil
+}
+
+func TestLocalValidationFallsBackToIntrospectionNearExpiry(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySetServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks.KeySet{Keys: []jwks.JSONWebKey{{
+			KeyID:   "key",
+			KeyType: "RSA",
+			N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	defer keySetServer.Close()
+
+	userID := uuid.New()
+	client := &fakeTokenClient{userID: userID.String()}
+	middleware, err := NewTokenMiddlewareWithOptions("", WithTokenServiceClient(client), WithLocalValidation(LocalValidationConfig{
+		KeySetURL:  keySetServer.URL,
+		Issuer:     testIssuer,
+		Scopes:     []string{"openid"},
+		HTTPClient: keySetServer.Client(),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signToken := func(expiresIn time.Duration) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":       testIssuer,
+			"sub":       userID.String(),
+			"exp":       time.Now().Add(expiresIn).Unix(),
+			"client_id": "client",
+			"scp":       []string{"openid", "offline"},
+			"ext":       models.UserProfile{ID: &userID, Email: "user@cisauth.org"},
+		})
+		token.Header["kid"] = "key"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	authenticate := func(token string) models.UserProfile {
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request = httptest.NewRequest(http.MethodGet, "/user", nil)
+		c.Request.Header.Set(constants.Authorization, constants.Bearer+" "+token)
+		c.Request.Header.Set(constants.Session, "session")
+		middleware.DoAuthenticate(c)
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("expected request to be authenticated, got status %d", recorder.Code)
+		}
+		return c.MustGet(constants.UserContext).(models.UserProfile)
+	}
+
+	if profile := authenticate(signToken(time.Hour)); profile.Email != "user@cisauth.org" {
+		t.Fatalf("expected profile from access token claims, got %+v", profile)
+	}
+	if client.introspection != 0 {
+		t.Fatalf("expected valid token to skip introspection, got %d calls", client.introspection)
+	}
+
+	authenticate(signToken(10 * time.Second))
+	if client.introspection != 1 {
+		t.Fatalf("expected token near expiry to be introspected, got %d calls", client.introspection)
+	}
+}
+
+func TestLocalValidationRejectsTokensWithoutScopeOrOfAnotherAudience(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	webKey, err := jwks.NewJSONWebKey("key", &key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySetServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks.KeySet{Keys: []jwks.JSONWebKey{webKey}})
+	}))
+	defer keySetServer.Close()
+
+	userID := uuid.New()
+	client := &fakeTokenClient{userID: userID.String()}
+	middleware, err := NewTokenMiddlewareWithOptions("", WithTokenServiceClient(client), WithLocalValidation(LocalValidationConfig{
+		KeySetURL:  keySetServer.URL,
+		Issuer:     testIssuer,
+		Audience:   "user-service",
+		Scopes:     []string{"openid"},
+		HTTPClient: keySetServer.Client(),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signToken := func(audience string, scopes []string, expiresIn time.Duration) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":       testIssuer,
+			"sub":       userID.String(),
+			"aud":       []string{audience},
+			"exp":       time.Now().Add(expiresIn).Unix(),
+			"client_id": "client",
+			"scp":       scopes,
+			"ext":       models.UserProfile{ID: &userID},
+		})
+		token.Header["kid"] = "key"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	authenticate := func(token string) int {
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request = httptest.NewRequest(http.MethodGet, "/user", nil)
+		c.Request.Header.Set(constants.Authorization, constants.Bearer+" "+token)
+		c.Request.Header.Set(constants.Session, "session")
+		middleware.DoAuthenticate(c)
+		return recorder.Code
+	}
+
+	if code := authenticate(signToken("user-service", []string{"offline"}, time.Hour)); code != http.StatusForbidden {
+		t.Fatalf("expected a token without the required scope to be forbidden, got status %d", code)
+	}
+	if code := authenticate(signToken("other-service", []string{"openid"}, time.Hour)); code != http.StatusUnauthorized {
+		t.Fatalf("expected a token of another audience to be rejected, got status %d", code)
+	}
+	if client.introspection != 0 {
+		t.Fatalf("expected rejected tokens not to be introspected, got %d calls", client.introspection)
+	}
+
+	if code := authenticate(signToken("user-service", []string{"openid"}, -time.Minute)); code != http.StatusOK {
+		t.Fatalf("expected an expired token to be refreshed through introspection, got status %d", code)
+	}
+	if client.introspection != 1 {
+		t.Fatalf("expected the expired token to be introspected, got %d calls", client.introspection)
+	}
+}
+
+func TestLocalValidationAcceptsExchangedTokensOfTrustedIssuers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	webKey, err := jwks.NewJSONWebKey("exchange", &key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySetServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks.KeySet{Keys: []jwks.JSONWebKey{webKey}})
+	}))
+	defer keySetServer.Close()
+
+	userID := uuid.New()
+	client := &fakeTokenClient{userID: userID.String()}
+	middleware, err := NewTokenMiddlewareWithOptions("", WithTokenServiceClient(client), WithLocalValidation(LocalValidationConfig{
+		KeySetURL:      keySetServer.URL,
+		Issuer:         testIssuer,
+		Audience:       "user-service",
+		HTTPClient:     keySetServer.Client(),
+		TrustedIssuers: []TrustedIssuer{{Issuer: "urn:cisauth:token-exchange", KeySetURL: keySetServer.URL}},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signToken := func(audience string, expiresIn time.Duration) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"iss":       "urn:cisauth:token-exchange",
+			"sub":       userID.String(),
+			"aud":       []string{audience},
+			"exp":       time.Now().Add(expiresIn).Unix(),
+			"client_id": "billing-service",
+			"scp":       []string{"api"},
+			"ext":       models.UserProfile{ID: &userID},
+			"act":       map[string]string{"sub": "billing-service"},
+		})
+		token.Header["kid"] = "exchange"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	authenticate := func(token string) (int, *gin.Context) {
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request = httptest.NewRequest(http.MethodGet, "/user", nil)
+		c.Request.Header.Set(constants.Authorization, constants.Bearer+" "+token)
+		c.Request.Header.Set(constants.Session, "session")
+		middleware.DoAuthenticate(c)
+		return recorder.Code, c
+	}
+
+	// exchanged tokens can't be refreshed, they are accepted until they expire.
+	code, c := authenticate(signToken("user-service", 10*time.Second))
+	if code != http.StatusOK {
+		t.Fatalf("expected the exchanged token to be accepted, got status %d", code)
+	}
+	if introspection := c.MustGet(constants.IntrospectionContext).(models.Introspection); introspection.Actor != "billing-service" {
+		t.Fatalf("expected the acting service, got %+v", introspection)
+	}
+
+	if code, _ := authenticate(signToken("other-service", time.Minute)); code != http.StatusUnauthorized {
+		t.Fatalf("expected an exchanged token of another audience to be rejected, got status %d", code)
+	}
+	if client.introspection != 0 {
+		t.Fatalf("expected exchanged tokens never to be introspected, got %d calls", client.introspection)
+	}
+}
@@ -0,0 +1,190 @@
+package authorization
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+const (
+	// wwwAuthenticate is the RFC 6750 challenge header.
+	wwwAuthenticate = "WWW-Authenticate"
+	// errInsufficientScope is the RFC 6750 error code for a token lacking the required scope.
+	errInsufficientScope = "insufficient_scope"
+	// errInvalidToken is the RFC 6750 error code for a token which is not accepted by the resource.
+	errInvalidToken = "invalid_token"
+)
+
+// RequireScopes allows the request only when the access token was granted all the scopes.
+// It must be registered after authentication.TokenMiddleware.DoAuthenticate.
+func RequireScopes(scopes ...string) gin.HandlerFunc {
+	return requireScopes(scopes, containsAll)
+}
+
+// RequireAnyScope allows the request when the access token was granted at least one of the scopes.
+func RequireAnyScope(scopes ...string) gin.HandlerFunc {
+	return requireScopes(scopes, containsAny)
+}
+
+// RequireAudience allows the request only when the access token is intended for all the audiences.
+func RequireAudience(audiences ...string) gin.HandlerFunc {
+	return requireAudience(audiences, containsAll)
+}
+
+// RequireAnyAudience allows the request when the access token is intended for at least one of the audiences.
+func RequireAnyAudience(audiences ...string) gin.HandlerFunc {
+	return requireAudience(audiences, containsAny)
+}
+
+// RequireRole allows the request when the authenticated user has at least one of the roles.
+// Roles are taken from the user profile in the context, which is the one of the id token unless a
+// middleware of the service loaded the current roles of the user before.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return requireProfile("roles", func(userProfile models.UserProfile) []string {
+		return userProfile.Roles
+	}, roles, containsAny)
+}
+
+// RequireAllRoles allows the request only when the authenticated user has all the roles.
+func RequireAllRoles(roles ...string) gin.HandlerFunc {
+	return requireProfile("roles", func(userProfile models.UserProfile) []string {
+		return userProfile.Roles
+	}, roles, containsAll)
+}
+
+// RequirePermissions allows the request only when the roles of the authenticated user grant all the permissions.
+// Permissions are taken from the user profile in the context like roles.
+func RequirePermissions(permissions ...string) gin.HandlerFunc {
+	return requireProfile("permissions", func(userProfile models.UserProfile) []string {
+		return userProfile.Permissions
+	}, permissions, containsAll)
+}
+
+func requireScopes(scopes []string, match func(granted, required []string) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		introspection, ok := introspectionFromContext(c)
+		if !ok {
+			return
+		}
+
+		if !match(introspection.Scopes, scopes) {
+			slog.ErrorContext(c.Request.Context(), "access token is missing required scopes", slog.Any("required", scopes), slog.Any("granted", introspection.Scopes))
+			c.Header(wwwAuthenticate, challenge(errInsufficientScope, "the access token is missing required scopes", strings.Join(scopes, " ")))
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"message": "insufficient scope",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func requireAudience(audiences []string, match func(granted, required []string) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		introspection, ok := introspectionFromContext(c)
+		if !ok {
+			return
+		}
+
+		if !match(introspection.Audience, audiences) {
+			slog.ErrorContext(c.Request.Context(), "access token is not intended for this audience", slog.Any("required", audiences), slog.Any("granted", introspection.Audience))
+			c.Header(wwwAuthenticate, challenge(errInvalidToken, "the access token is not intended for this audience", ""))
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"message": "invalid audience",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// requireProfile matches the values the granted function reads from the user profile against the required values,
+// kind names the values in logs and responses.
+func requireProfile(kind string, granted func(models.UserProfile) []string, required []string, match func(granted, required []string) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, _ := c.Get(constants.UserContext)
+		userProfile, ok := value.(models.UserProfile)
+		if !ok {
+			slog.ErrorContext(c.Request.Context(), "user profile not found in context")
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"message": "missing/invalid authentication headers",
+			})
+			return
+		}
+
+		if !match(granted(userProfile), required) {
+			slog.ErrorContext(c.Request.Context(), "user is missing required "+kind, slog.Any("required", required), slog.Any("granted", granted(userProfile)))
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"message": "insufficient " + strings.TrimSuffix(kind, "s"),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// introspectionFromContext returns the token grant stored by the authentication middleware,
+// aborting the request when it is missing.
+func introspectionFromContext(c *gin.Context) (models.Introspection, bool) {
+	value, ok := c.Get(constants.IntrospectionContext)
+	introspection, valid := value.(models.Introspection)
+	if !ok || !valid {
+		slog.ErrorContext(c.Request.Context(), "introspection result not found in context")
+		c.Header(wwwAuthenticate, challenge(errInvalidToken, "the access token could not be verified", ""))
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"message": "missing/invalid authentication headers",
+		})
+		return models.Introspection{}, false
+	}
+
+	return introspection, true
+}
+
+// challenge builds a Bearer WWW-Authenticate header value as defined in RFC 6750 section 3.
+func challenge(code, description, scope string) string {
+	value := fmt.Sprintf(`%s error="%s", error_description="%s"`, constants.Bearer, code, description)
+	if len(scope) != 0 {
+		value = fmt.Sprintf(`%s, scope="%s"`, value, scope)
+	}
+
+	return value
+}
+
+func containsAll(granted, required []string) bool {
+	for _, r := range required {
+		if !contains(granted, r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsAny(granted, required []string) bool {
+	for _, r := range required {
+		if contains(granted, r) {
+			return true
+		}
+	}
+
+	return len(required) == 0
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
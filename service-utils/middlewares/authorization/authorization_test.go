@@ -0,0 +1,111 @@
+package authorization
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+func TestRequireScopesAndAudience(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	introspection := models.Introspection{
+		Scopes:   []string{"openid", "profile"},
+		Audience: []string{"user-service"},
+	}
+
+	tests := []struct {
+		name       string
+		middleware gin.HandlerFunc
+		status     int
+		challenge  string
+	}{
+		{"all scopes granted", RequireScopes("openid", "profile"), http.StatusOK, ""},
+		{"one scope missing", RequireScopes("openid", "email"), http.StatusForbidden,
+			`Bearer error="insufficient_scope", error_description="the access token is missing required scopes", scope="openid email"`},
+		{"any scope granted", RequireAnyScope("email", "profile"), http.StatusOK, ""},
+		{"no scope granted", RequireAnyScope("email", "admin"), http.StatusForbidden,
+			`Bearer error="insufficient_scope", error_description="the access token is missing required scopes", scope="email admin"`},
+		{"audience granted", RequireAudience("user-service"), http.StatusOK, ""},
+		{"audience missing", RequireAudience("user-service", "token-service"), http.StatusUnauthorized,
+			`Bearer error="invalid_token", error_description="the access token is not intended for this audience"`},
+		{"any audience granted", RequireAnyAudience("token-service", "user-service"), http.StatusOK, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/", func(c *gin.Context) {
+				c.Set(constants.IntrospectionContext, introspection)
+			}, test.middleware, func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if recorder.Code != test.status {
+				t.Fatalf("expected status %d, got %d", test.status, recorder.Code)
+			}
+			if challenge := recorder.Header().Get(wwwAuthenticate); challenge != test.challenge {
+				t.Fatalf("expected challenge %q, got %q", test.challenge, challenge)
+			}
+		})
+	}
+}
+
+func TestRequireScopesWithoutAuthentication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/", RequireScopes("openid"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, recorder.Code)
+	}
+}
+
+func TestRequireRoleAndPermissions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name       string
+		middleware gin.HandlerFunc
+		status     int
+	}{
+		{"any role granted", RequireRole("admin", "user"), http.StatusOK},
+		{"role missing", RequireRole("admin"), http.StatusForbidden},
+		{"all roles granted", RequireAllRoles("user", "support"), http.StatusOK},
+		{"one role missing", RequireAllRoles("user", "admin"), http.StatusForbidden},
+		{"all permissions granted", RequirePermissions("profile:read", "users:read"), http.StatusOK},
+		{"one permission missing", RequirePermissions("users:read", "users:write"), http.StatusForbidden},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/", func(c *gin.Context) {
+				c.Set(constants.UserContext, models.UserProfile{
+					Roles:       []string{"user", "support"},
+					Permissions: []string{"profile:read", "users:read"},
+				})
+			}, test.middleware, func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if recorder.Code != test.status {
+				t.Fatalf("expected status %d, got %d", test.status, recorder.Code)
+			}
+		})
+	}
+}
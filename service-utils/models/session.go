@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Session is the authenticated session of a request.
+type Session struct {
+	ID          string `json:"id"`
+	AccessToken string `json:"accessToken"`
+	ClientID    string `json:"clientID"`
+}
+
+// Introspection is the authorization granted to the access token of a request. Actor is the internal service acting
+// on behalf of the user with an exchanged access token.
+type Introspection struct {
+	ClientID  string    `json:"clientID"`
+	Subject   string    `json:"subject"`
+	Scopes    []string  `json:"scopes"`
+	Audience  []string  `json:"audience"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Actor     string    `json:"actor,omitempty"`
+}
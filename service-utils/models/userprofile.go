@@ -6,7 +6,9 @@ import (
 
 // UserProfile is a user profile model for jwt claims.
 type UserProfile struct {
-	ID    *uuid.UUID `json:"id"`
-	Email string     `json:"email"`
-	Name  string     `json:"name"`
+	ID          *uuid.UUID `json:"id"`
+	Email       string     `json:"email"`
+	Name        string     `json:"name"`
+	Roles       []string   `json:"roles,omitempty"`
+	Permissions []string   `json:"permissions,omitempty"`
 }
@@ -20,6 +20,8 @@ var (
 		"oAuthServerAdminBaseURL.required":       errors.New(isRequired),
 		"oAuthServerPublicBaseURL.url":           errors.New("oauth2 url is invalid"),
 		"oAuthServerAdminBaseURL.url":            errors.New("oauth2 url is invalid"),
+		"oAuthServerIssuerURL.required":          errors.New(isRequired),
+		"oAuthServerIssuerURL.url":               errors.New("oauth2 url is invalid"),
 		"redisHost.required":                     errors.New(isRequired),
 		"redisPort.required":                     errors.New(isRequired),
 		"appinsightsInstrumentationKey.required": errors.New(isRequired),
@@ -23,13 +23,18 @@ type AppConfig struct {
 }
 
 // App represents cisauth token app config.
+// Clients seed the oauth2 client registry and replace the clients they seeded on every start, clients are managed
+// through the client api once they were changed there.
 type App struct {
 	GRPCPort                 int                      `json:"grpcPort" validate:"required"`
-	Clients                  map[string]Client        `json:"clients" validate:"required"`
+	Clients                  map[string]Client        `json:"clients"`
 	OAuthServerPublicBaseURL string                   `json:"oAuthServerPublicBaseURL" validate:"required,url"`
 	OAuthServerAdminBaseURL  string                   `json:"oAuthServerAdminBaseURL" validate:"required,url"`
+	OAuthServerIssuerURL     string                   `json:"oAuthServerIssuerURL" validate:"required,url"`
 	SecretKeys               AppSecretKeys            `json:"secretKeys"`
 	CredentialsResetSettings CredentialsResetSettings `json:"credentialsResetSettings"`
+	ClientRegistration       ClientRegistrationPolicy `json:"clientRegistration"`
+	TokenExchange            *TokenExchange           `json:"tokenExchange"`
 }
 type Secrets struct {
 	RedisDBPassword string `json:"REDIS_DB_PASSWORD"`
@@ -38,9 +43,43 @@ type Secrets struct {
 }
 
 // Client represents oauth2 clients.
+// Trusted marks first-party clients which get the requested scopes without prompting the user for consent.
+// LoginRememberForSeconds and ConsentRememberForSeconds are how long a remembered login or consent is kept by the
+// oauth2 server, remember-me is not offered for clients which leave them at zero.
 type Client struct {
-	Secret      string `json:"secret"`
-	RedirectURI string `json:"redirectURI"`
+	Secret                    string `json:"secret"`
+	RedirectURI               string `json:"redirectURI"`
+	Trusted                   bool   `json:"trusted"`
+	LoginRememberForSeconds   int    `json:"loginRememberForSeconds" validate:"gte=0"`
+	ConsentRememberForSeconds int    `json:"consentRememberForSeconds" validate:"gte=0"`
+}
+
+// ClientRegistrationPolicy represents what oauth2 clients registering themselves through dynamic client registration
+// may ask for. InitialAccessTokenTTLSeconds is the longest an initial access token issued by an admin stays valid.
+type ClientRegistrationPolicy struct {
+	AllowedGrantTypes               []string `json:"allowedGrantTypes"`
+	AllowedResponseTypes            []string `json:"allowedResponseTypes"`
+	AllowedScopes                   []string `json:"allowedScopes"`
+	AllowedTokenEndpointAuthMethods []string `json:"allowedTokenEndpointAuthMethods"`
+	InitialAccessTokenTTLSeconds    int      `json:"initialAccessTokenTTLSeconds" validate:"gt=0"`
+}
+
+// TokenExchange represents how user access tokens are exchanged for down-scoped tokens of internal services (RFC 8693).
+// It is optional, user access tokens are only exchanged when it is configured. SigningKey is the PEM encoded EC P-256
+// or RSA private key the exchanged tokens are signed with, its public key is served by the token exchange key set of
+// the user service under KeyID. Actors lists the oauth2 clients of the internal services allowed to act on behalf of
+// a user.
+type TokenExchange struct {
+	Issuer        string                        `json:"issuer" validate:"required"`
+	KeyID         string                        `json:"keyID" validate:"required"`
+	SigningKey    string                        `json:"signingKey" validate:"required"`
+	MaxTTLSeconds int                           `json:"maxTTLSeconds" validate:"gt=0"`
+	Actors        map[string]TokenExchangeActor `json:"actors" validate:"required,min=1,dive"`
+}
+
+// TokenExchangeActor represents the audiences an internal service may exchange user access tokens for.
+type TokenExchangeActor struct {
+	Audiences []string `json:"audiences" validate:"required,min=1"`
 }
 
 // CredentialsResetSettings represents reset config for forgot Credentials.
@@ -0,0 +1,279 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+const (
+	// tokenEndpointAuthNone is the token endpoint auth method of public clients, which have no secret.
+	tokenEndpointAuthNone = "none"
+	clientSecretBytes     = 32
+)
+
+// ClientManager provides abstraction for managing the oauth2 clients of the oauth2 server.
+type ClientManager interface {
+	GetClient(ctx context.Context, clientID string) (*model.ClientRegistration, error)
+	CreateClient(ctx context.Context, registration model.ClientRegistration) (*model.ClientRegistration, error)
+	UpdateClient(ctx context.Context, clientID string, registration model.ClientRegistration) (*model.ClientRegistration, error)
+	RotateClientSecret(ctx context.Context, clientID string) (*model.ClientRegistration, error)
+	DeleteClient(ctx context.Context, clientID string) error
+}
+
+// ClientService manages oauth2 clients through the oauth2 server admin api and keeps the client registry in sync.
+type ClientService struct {
+	httpClient *http.Client
+	appConfig  *config.App
+	registry   *ClientRegistry
+}
+
+// GetClient returns the oauth2 client with its registered secret.
+func (s *ClientService) GetClient(ctx context.Context, clientID string) (*model.ClientRegistration, error) {
+	existing, err := s.registry.Client(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.adminRequest(ctx, http.MethodGet, clientID, nil)
+	if err != nil {
+		return nil, err
+	}
+	client.ClientSecret = existing.Secret
+	client.Trusted = existing.Trusted
+	client.LoginRememberForSeconds = existing.LoginRememberForSeconds
+	client.ConsentRememberForSeconds = existing.ConsentRememberForSeconds
+
+	return client, nil
+}
+
+// CreateClient creates the oauth2 client with a generated secret, the secret is only returned here and on rotation.
+func (s *ClientService) CreateClient(ctx context.Context, registration model.ClientRegistration) (*model.ClientRegistration, error) {
+	registration.ClientID = ""
+	registration.ClientSecret = ""
+	if registration.TokenEndpointAuthMethod != tokenEndpointAuthNone {
+		secret, err := clientSecret()
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to generate client secret", slog.Any(utilconstants.Error, err))
+			return nil, err
+		}
+		registration.ClientSecret = secret
+	}
+
+	created, err := s.adminRequest(ctx, http.MethodPost, "", registration)
+	if err != nil {
+		return nil, err
+	}
+	created.ClientSecret = registration.ClientSecret
+	copyRegistryFields(created, registration)
+
+	if err := s.registry.Save(ctx, created.ClientID, registryClient(created, created.ClientSecret)); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "created oauth2 client", slog.String("clientID", created.ClientID))
+
+	return created, nil
+}
+
+// UpdateClient replaces the metadata of the oauth2 client, its secret is kept.
+func (s *ClientService) UpdateClient(ctx context.Context, clientID string, registration model.ClientRegistration) (*model.ClientRegistration, error) {
+	existing, err := s.registry.Client(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	registration.ClientID = clientID
+	registration.ClientSecret = ""
+	updated, err := s.adminRequest(ctx, http.MethodPut, clientID, registration)
+	if err != nil {
+		return nil, err
+	}
+	updated.ClientSecret = ""
+	copyRegistryFields(updated, registration)
+
+	if err := s.registry.Save(ctx, clientID, registryClient(updated, existing.Secret)); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "updated oauth2 client", slog.String("clientID", clientID))
+
+	return updated, nil
+}
+
+// RotateClientSecret replaces the secret of the oauth2 client with a generated one, the old secret stops working
+// right away.
+func (s *ClientService) RotateClientSecret(ctx context.Context, clientID string) (*model.ClientRegistration, error) {
+	existing, err := s.registry.Client(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := clientSecret()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to generate client secret", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	rotated, err := s.adminRequest(ctx, http.MethodPatch, clientID, []model.JSONPatchOperation{
+		{Op: "replace", Path: "/client_secret", Value: secret},
+	})
+	if err != nil {
+		return nil, err
+	}
+	rotated.ClientSecret = secret
+	rotated.Trusted = existing.Trusted
+	rotated.LoginRememberForSeconds = existing.LoginRememberForSeconds
+	rotated.ConsentRememberForSeconds = existing.ConsentRememberForSeconds
+
+	existing.Secret = secret
+	if err := s.registry.Save(ctx, clientID, existing); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "rotated oauth2 client secret", slog.String("clientID", clientID))
+
+	return rotated, nil
+}
+
+// DeleteClient deletes the oauth2 client, the oauth2 server revokes its tokens.
+func (s *ClientService) DeleteClient(ctx context.Context, clientID string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.clientEndpoint(clientID), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create delete client request", slog.Any(utilconstants.Error, err))
+		return err
+	}
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to make delete client request", slog.Any(utilconstants.Error, err))
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		slog.ErrorContext(ctx, "unexpected status code returned for delete client request", slog.Int("statusCode", response.StatusCode))
+		return clientError(response.StatusCode)
+	}
+
+	if err := s.registry.Delete(ctx, clientID); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "deleted oauth2 client", slog.String("clientID", clientID))
+
+	return nil
+}
+
+// adminRequest sends the payload to the oauth2 server admin client endpoint and returns the resulting client,
+// requests without a payload have no body.
+func (s *ClientService) adminRequest(ctx context.Context, method, clientID string, payload any) (*model.ClientRegistration, error) {
+	var body io.Reader
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to marshal client request", slog.Any(utilconstants.Error, err))
+			return nil, err
+		}
+		body = bytes.NewBuffer(payloadBytes)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, s.clientEndpoint(clientID), body)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create client request", slog.Any(utilconstants.Error, err), slog.String("method", method))
+		return nil, err
+	}
+	request.Header.Set(contentType, "application/json")
+
+	slog.InfoContext(ctx, "making oauth2 client request", slog.String("method", method))
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to make client request", slog.Any(utilconstants.Error, err), slog.String("method", method))
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		slog.ErrorContext(ctx, "unexpected status code returned for client request", slog.Int("statusCode", response.StatusCode),
+			slog.String("method", method))
+		return nil, clientError(response.StatusCode)
+	}
+
+	var registration model.ClientRegistration
+	if err := json.NewDecoder(response.Body).Decode(&registration); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal client response", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	return &registration, nil
+}
+
+func (s *ClientService) clientEndpoint(clientID string) string {
+	endpoint := strings.Join([]string{s.appConfig.OAuthServerAdminBaseURL, "clients"}, "/")
+	if len(clientID) == 0 {
+		return endpoint
+	}
+	return strings.Join([]string{endpoint, clientID}, "/")
+}
+
+// clientError tells unknown clients apart from client metadata rejected by the oauth2 server.
+func clientError(statusCode int) error {
+	switch statusCode {
+	case http.StatusNotFound:
+		return ErrClientNotFound
+	case http.StatusBadRequest, http.StatusConflict:
+		return ErrInvalidClientMetadata
+	}
+	return ErrClientManagement
+}
+
+// copyRegistryFields copies the registration fields which only the client registry keeps.
+func copyRegistryFields(to *model.ClientRegistration, from model.ClientRegistration) {
+	to.Trusted = from.Trusted
+	to.LoginRememberForSeconds = from.LoginRememberForSeconds
+	to.ConsentRememberForSeconds = from.ConsentRememberForSeconds
+}
+
+// registryClient converts a client of the oauth2 server into its client registry entry, token requests are made
+// with its first redirect uri.
+func registryClient(registration *model.ClientRegistration, secret string) config.Client {
+	client := config.Client{
+		Secret:                    secret,
+		Trusted:                   registration.Trusted,
+		LoginRememberForSeconds:   registration.LoginRememberForSeconds,
+		ConsentRememberForSeconds: registration.ConsentRememberForSeconds,
+	}
+	if len(registration.RedirectURIs) != 0 {
+		client.RedirectURI = registration.RedirectURIs[0]
+	}
+
+	return client
+}
+
+func clientSecret() (string, error) {
+	b := make([]byte, clientSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// NewClientService creates a new object for ClientService.
+func NewClientService(client *http.Client, app *config.App, registry *ClientRegistry) *ClientService {
+	return &ClientService{
+		httpClient: client,
+		appConfig:  app,
+		registry:   registry,
+	}
+}
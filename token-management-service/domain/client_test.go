@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+// newTestClientService creates a ClientService backed by miniredis and an oauth2 server keeping its clients in
+// memory.
+func newTestClientService(t *testing.T) (*ClientService, *ClientRegistry, map[string]model.ClientRegistration) {
+	t.Helper()
+	clients := make(map[string]model.ClientRegistration)
+	oauth2Server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/admin/clients"), "/")
+		registration, exists := clients[clientID]
+		switch {
+		case r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&registration)
+			if len(registration.RedirectURIs) == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			registration.ClientID = "created"
+			clients[registration.ClientID] = registration
+		case !exists:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case r.Method == http.MethodPut:
+			_ = json.NewDecoder(r.Body).Decode(&registration)
+			registration.ClientSecret = clients[clientID].ClientSecret
+			clients[clientID] = registration
+		case r.Method == http.MethodPatch:
+			patch := make([]model.JSONPatchOperation, 0)
+			_ = json.NewDecoder(r.Body).Decode(&patch)
+			registration.ClientSecret, _ = patch[0].Value.(string)
+			clients[clientID] = registration
+		case r.Method == http.MethodDelete:
+			delete(clients, clientID)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		registration.ClientSecret = ""
+		_ = json.NewEncoder(w).Encode(registration)
+	}))
+	t.Cleanup(oauth2Server.Close)
+
+	registry := newTestClientRegistry(t)
+	app := &config.App{OAuthServerAdminBaseURL: oauth2Server.URL + "/admin"}
+
+	return NewClientService(oauth2Server.Client(), app, registry), registry, clients
+}
+
+func TestClientServiceKeepsTheRegistryInSyncWithTheOAuth2Server(t *testing.T) {
+	ctx := context.Background()
+	clientService, registry, clients := newTestClientService(t)
+
+	created, err := clientService.CreateClient(ctx, model.ClientRegistration{
+		ClientName:              "client",
+		RedirectURIs:            []string{"https://client.cisauth.org/callback"},
+		TokenEndpointAuthMethod: "client_secret_basic",
+		Trusted:                 true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.ClientSecret) == 0 || clients[created.ClientID].ClientSecret != created.ClientSecret {
+		t.Fatal("expected a generated secret registered with the oauth2 server")
+	}
+	registered, err := registry.Client(ctx, created.ClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if registered.Secret != created.ClientSecret || !registered.Trusted || registered.RedirectURI != "https://client.cisauth.org/callback" {
+		t.Fatalf("expected the created client in the registry, got %+v", registered)
+	}
+
+	updated, err := clientService.UpdateClient(ctx, created.ClientID, model.ClientRegistration{
+		ClientName:   "renamed",
+		RedirectURIs: []string{"https://client.cisauth.org/renamed"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.ClientSecret) != 0 {
+		t.Fatal("expected the secret not to be returned on update")
+	}
+	registered, _ = registry.Client(ctx, created.ClientID)
+	if registered.Secret != created.ClientSecret || registered.Trusted || registered.RedirectURI != "https://client.cisauth.org/renamed" {
+		t.Fatalf("expected the updated client to keep its secret, got %+v", registered)
+	}
+
+	rotated, err := clientService.RotateClientSecret(ctx, created.ClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registered, _ = registry.Client(ctx, created.ClientID)
+	if rotated.ClientSecret == created.ClientSecret || registered.Secret != rotated.ClientSecret ||
+		clients[created.ClientID].ClientSecret != rotated.ClientSecret {
+		t.Fatal("expected the rotated secret in the registry and the oauth2 server")
+	}
+
+	client, err := clientService.GetClient(ctx, created.ClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.ClientName != "renamed" || client.ClientSecret != rotated.ClientSecret {
+		t.Fatalf("expected the client with its registered secret, got %+v", client)
+	}
+
+	if err := clientService.DeleteClient(ctx, created.ClientID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Client(ctx, created.ClientID); !errors.Is(err, ErrClientNotFound) {
+		t.Fatalf("expected the deleted client to be removed from the registry, got %v", err)
+	}
+	if _, err := clientService.GetClient(ctx, created.ClientID); !errors.Is(err, ErrClientNotFound) {
+		t.Fatalf("expected the deleted client not to be found, got %v", err)
+	}
+}
+
+func TestClientServiceCreatesPublicClientsWithoutSecret(t *testing.T) {
+	ctx := context.Background()
+	clientService, registry, _ := newTestClientService(t)
+
+	created, err := clientService.CreateClient(ctx, model.ClientRegistration{
+		ClientName:              "spa",
+		RedirectURIs:            []string{"https://spa.cisauth.org/callback"},
+		TokenEndpointAuthMethod: tokenEndpointAuthNone,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	registered, err := registry.Client(ctx, created.ClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.ClientSecret) != 0 || len(registered.Secret) != 0 {
+		t.Fatal("expected public clients to have no secret")
+	}
+}
+
+func TestClientServiceRejectsInvalidMetadataWithoutRegistering(t *testing.T) {
+	ctx := context.Background()
+	clientService, registry, _ := newTestClientService(t)
+
+	if _, err := clientService.CreateClient(ctx, model.ClientRegistration{ClientName: "client"}); !errors.Is(err, ErrInvalidClientMetadata) {
+		t.Fatalf("expected the client metadata to be rejected, got %v", err)
+	}
+	if _, err := registry.Client(ctx, "created"); !errors.Is(err, ErrClientNotFound) {
+		t.Fatalf("expected the rejected client not to be registered, got %v", err)
+	}
+	if _, err := clientService.RotateClientSecret(ctx, "unknown"); !errors.Is(err, ErrClientNotFound) {
+		t.Fatalf("expected an unknown client not to be found, got %v", err)
+	}
+}
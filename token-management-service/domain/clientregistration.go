@@ -0,0 +1,315 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+const (
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeImplicit          = "implicit"
+	grantTypeClientCredentials = "client_credentials"
+	responseTypeCode           = "code"
+	tokenEndpointAuthBasic     = "client_secret_basic"
+)
+
+// ClientRegistrar provides abstraction for dynamic client registration (RFC 7591) and the management of the
+// registered clients with their registration access token (RFC 7592).
+type ClientRegistrar interface {
+	IssueInitialAccessToken(ctx context.Context, expiresIn time.Duration) (string, time.Time, error)
+	RegisterClient(ctx context.Context, initialAccessToken string, registration model.ClientRegistration) (*model.RegisteredClient, error)
+	GetRegisteredClient(ctx context.Context, clientID, registrationAccessToken string) (*model.RegisteredClient, error)
+	UpdateRegisteredClient(ctx context.Context, clientID, registrationAccessToken string, registration model.ClientRegistration) (*model.RegisteredClient, error)
+	DeleteRegisteredClient(ctx context.Context, clientID, registrationAccessToken string) error
+}
+
+// ClientRegistrationService lets partners register their own oauth2 clients within the client registration policy.
+type ClientRegistrationService struct {
+	redisClient *redis.Client
+	clients     ClientManager
+	policy      config.ClientRegistrationPolicy
+}
+
+// IssueInitialAccessToken issues a single use token which allows registering one client, it expires after the
+// requested duration capped to the policy.
+func (s *ClientRegistrationService) IssueInitialAccessToken(ctx context.Context, expiresIn time.Duration) (string, time.Time, error) {
+	maxExpiry := time.Duration(s.policy.InitialAccessTokenTTLSeconds) * time.Second
+	if expiresIn <= 0 || expiresIn > maxExpiry {
+		expiresIn = maxExpiry
+	}
+
+	token, err := clientSecret()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to generate initial access token", slog.Any(utilconstants.Error, err))
+		return "", time.Time{}, err
+	}
+	if err := s.redisClient.Set(ctx, initialAccessTokenKey(token), 1, expiresIn).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store initial access token", slog.Any(utilconstants.Error, err))
+		return "", time.Time{}, err
+	}
+
+	slog.InfoContext(ctx, "issued initial access token", slog.Duration("expiresIn", expiresIn))
+
+	return token, time.Now().UTC().Add(expiresIn), nil
+}
+
+// RegisterClient registers a client with the initial access token, which is used up by the registration. The response
+// carries the client secret and the registration access token, neither can be read in plain text again. The initial
+// access token can be used again when the registration fails.
+func (s *ClientRegistrationService) RegisterClient(ctx context.Context, initialAccessToken string, registration model.ClientRegistration) (*model.RegisteredClient, error) {
+	expiresIn, err := s.claimInitialAccessToken(ctx, initialAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	registration, err = s.applyPolicy(registration)
+	if err != nil {
+		slog.InfoContext(ctx, "client registration rejected by policy", slog.Any(utilconstants.Error, err))
+		s.restoreInitialAccessToken(ctx, initialAccessToken, expiresIn)
+		return nil, err
+	}
+
+	registrationAccessToken, err := clientSecret()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to generate registration access token", slog.Any(utilconstants.Error, err))
+		s.restoreInitialAccessToken(ctx, initialAccessToken, expiresIn)
+		return nil, err
+	}
+
+	created, err := s.clients.CreateClient(ctx, registration)
+	if err != nil {
+		s.restoreInitialAccessToken(ctx, initialAccessToken, expiresIn)
+		return nil, err
+	}
+
+	if err := s.redisClient.Set(ctx, registrationAccessTokenKey(created.ClientID), tokenHash(registrationAccessToken), 0).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store registration access token", slog.Any(utilconstants.Error, err))
+		if err := s.clients.DeleteClient(ctx, created.ClientID); err != nil {
+			slog.ErrorContext(ctx, "unable to roll back client registration", slog.Any(utilconstants.Error, err),
+				slog.String("clientID", created.ClientID))
+		}
+		s.restoreInitialAccessToken(ctx, initialAccessToken, expiresIn)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "registered oauth2 client", slog.String("clientID", created.ClientID))
+
+	return &model.RegisteredClient{
+		ClientRegistration:      *created,
+		RegistrationAccessToken: registrationAccessToken,
+		ClientIDIssuedAt:        time.Now().UTC(),
+	}, nil
+}
+
+// claimInitialAccessToken uses up the initial access token, so concurrent registrations can't use it twice, and
+// returns how long it was still valid.
+func (s *ClientRegistrationService) claimInitialAccessToken(ctx context.Context, initialAccessToken string) (time.Duration, error) {
+	key := initialAccessTokenKey(initialAccessToken)
+	pipeline := s.redisClient.TxPipeline()
+	expiresIn := pipeline.PTTL(ctx, key)
+	deleted := pipeline.Del(ctx, key)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to use up initial access token", slog.Any(utilconstants.Error, err))
+		return 0, err
+	}
+	if deleted.Val() == 0 {
+		return 0, ErrInvalidInitialAccessToken
+	}
+
+	return expiresIn.Val(), nil
+}
+
+// restoreInitialAccessToken makes the initial access token usable again for the rest of its validity after the
+// registration it was claimed for failed.
+func (s *ClientRegistrationService) restoreInitialAccessToken(ctx context.Context, initialAccessToken string, expiresIn time.Duration) {
+	if expiresIn <= 0 {
+		return
+	}
+	if err := s.redisClient.Set(ctx, initialAccessTokenKey(initialAccessToken), 1, expiresIn).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to restore initial access token", slog.Any(utilconstants.Error, err))
+	}
+}
+
+// GetRegisteredClient returns the registered client the registration access token belongs to, without its secret.
+func (s *ClientRegistrationService) GetRegisteredClient(ctx context.Context, clientID, registrationAccessToken string) (*model.RegisteredClient, error) {
+	if err := s.verifyRegistrationAccessToken(ctx, clientID, registrationAccessToken); err != nil {
+		return nil, err
+	}
+
+	client, err := s.clients.GetClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	client.ClientSecret = ""
+
+	return &model.RegisteredClient{ClientRegistration: *client, RegistrationAccessToken: registrationAccessToken}, nil
+}
+
+// UpdateRegisteredClient replaces the metadata of the registered client within the client registration policy.
+func (s *ClientRegistrationService) UpdateRegisteredClient(ctx context.Context, clientID, registrationAccessToken string, registration model.ClientRegistration) (*model.RegisteredClient, error) {
+	if err := s.verifyRegistrationAccessToken(ctx, clientID, registrationAccessToken); err != nil {
+		return nil, err
+	}
+
+	registration, err := s.applyPolicy(registration)
+	if err != nil {
+		slog.InfoContext(ctx, "client registration update rejected by policy", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	updated, err := s.clients.UpdateClient(ctx, clientID, registration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RegisteredClient{ClientRegistration: *updated, RegistrationAccessToken: registrationAccessToken}, nil
+}
+
+// DeleteRegisteredClient deletes the registered client, the client registry deletes its registration access token.
+func (s *ClientRegistrationService) DeleteRegisteredClient(ctx context.Context, clientID, registrationAccessToken string) error {
+	if err := s.verifyRegistrationAccessToken(ctx, clientID, registrationAccessToken); err != nil {
+		return err
+	}
+
+	return s.clients.DeleteClient(ctx, clientID)
+}
+
+func (s *ClientRegistrationService) verifyRegistrationAccessToken(ctx context.Context, clientID, registrationAccessToken string) error {
+	hash, err := s.redisClient.Get(ctx, registrationAccessTokenKey(clientID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return ErrInvalidRegistrationAccessToken
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch registration access token", slog.Any(utilconstants.Error, err))
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(tokenHash(registrationAccessToken))) != 1 {
+		slog.InfoContext(ctx, "registration access token does not belong to client", slog.String("clientID", clientID))
+		return ErrInvalidRegistrationAccessToken
+	}
+
+	return nil
+}
+
+// applyPolicy fills in the defaults of RFC 7591 and checks the registration against the client registration policy.
+// Registered clients are never trusted and get no remember-me durations.
+func (s *ClientRegistrationService) applyPolicy(registration model.ClientRegistration) (model.ClientRegistration, error) {
+	if len(registration.GrantTypes) == 0 {
+		registration.GrantTypes = []string{grantTypeAuthorizationCode}
+	}
+	if len(registration.ResponseTypes) == 0 {
+		registration.ResponseTypes = []string{responseTypeCode}
+	}
+	if len(registration.TokenEndpointAuthMethod) == 0 {
+		registration.TokenEndpointAuthMethod = tokenEndpointAuthBasic
+	}
+	registration.Trusted = false
+	registration.LoginRememberForSeconds = 0
+	registration.ConsentRememberForSeconds = 0
+
+	if err := checkAllowed("grant type", registration.GrantTypes, s.policy.AllowedGrantTypes); err != nil {
+		return registration, err
+	}
+	if err := checkAllowed("response type", registration.ResponseTypes, s.policy.AllowedResponseTypes); err != nil {
+		return registration, err
+	}
+	if err := checkAllowed("scope", strings.Fields(registration.Scope), s.policy.AllowedScopes); err != nil {
+		return registration, err
+	}
+	if err := checkAllowed("token endpoint auth method", []string{registration.TokenEndpointAuthMethod}, s.policy.AllowedTokenEndpointAuthMethods); err != nil {
+		return registration, err
+	}
+	if registration.TokenEndpointAuthMethod == tokenEndpointAuthNone && slices.Contains(registration.GrantTypes, grantTypeClientCredentials) {
+		return registration, fmt.Errorf("%w: public clients cannot use the client_credentials grant", ErrInvalidClientMetadata)
+	}
+
+	redirectGrant := slices.Contains(registration.GrantTypes, grantTypeAuthorizationCode) || slices.Contains(registration.GrantTypes, grantTypeImplicit)
+	if redirectGrant && len(registration.RedirectURIs) == 0 {
+		return registration, fmt.Errorf("%w: redirect_uris are required for the requested grant types", ErrInvalidRedirectURI)
+	}
+	for _, redirectURI := range registration.RedirectURIs {
+		if err := validateRedirectURI(redirectURI); err != nil {
+			return registration, err
+		}
+	}
+
+	return registration, nil
+}
+
+// validateRedirectURI only allows absolute redirect uris without fragment, which use https unless they point to the
+// loopback interface of a native app.
+func validateRedirectURI(redirectURI string) error {
+	parsed, err := url.Parse(redirectURI)
+	if err != nil || !parsed.IsAbs() || len(parsed.Host) == 0 {
+		return fmt.Errorf("%w: %s is not an absolute uri", ErrInvalidRedirectURI, redirectURI)
+	}
+	if len(parsed.Fragment) != 0 {
+		return fmt.Errorf("%w: %s must not have a fragment", ErrInvalidRedirectURI, redirectURI)
+	}
+	if parsed.Scheme == "https" {
+		return nil
+	}
+	if parsed.Scheme == "http" && isLoopback(parsed.Hostname()) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s must use https", ErrInvalidRedirectURI, redirectURI)
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func checkAllowed(name string, values, allowed []string) error {
+	for _, value := range values {
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf("%w: %s %s is not allowed", ErrInvalidClientMetadata, name, value)
+		}
+	}
+
+	return nil
+}
+
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func initialAccessTokenKey(token string) string {
+	return strings.Join([]string{model.RedisInitialAccessTokenKeyPrefix, tokenHash(token)}, ":")
+}
+
+func registrationAccessTokenKey(clientID string) string {
+	return strings.Join([]string{model.RedisRegistrationAccessTokenKeyPrefix, clientID}, ":")
+}
+
+// NewClientRegistrationService creates a new object for ClientRegistrationService.
+func NewClientRegistrationService(redisClient *redis.Client, clients ClientManager, policy config.ClientRegistrationPolicy) *ClientRegistrationService {
+	return &ClientRegistrationService{
+		redisClient: redisClient,
+		clients:     clients,
+		policy:      policy,
+	}
+}
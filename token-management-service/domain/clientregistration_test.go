@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+var testRegistrationPolicy = config.ClientRegistrationPolicy{
+	AllowedGrantTypes:               []string{grantTypeAuthorizationCode, "refresh_token", grantTypeClientCredentials},
+	AllowedResponseTypes:            []string{responseTypeCode},
+	AllowedScopes:                   []string{"openid", "offline", "api"},
+	AllowedTokenEndpointAuthMethods: []string{tokenEndpointAuthBasic, tokenEndpointAuthNone},
+	InitialAccessTokenTTLSeconds:    3600,
+}
+
+// failingClientManager fails to create clients at the oauth2 server.
+type failingClientManager struct {
+	ClientManager
+}
+
+func (failingClientManager) CreateClient(context.Context, model.ClientRegistration) (*model.ClientRegistration, error) {
+	return nil, ErrClientManagement
+}
+
+func TestApplyPolicyFillsInDefaultsAndNeverTrustsClients(t *testing.T) {
+	registrations := NewClientRegistrationService(nil, nil, testRegistrationPolicy)
+
+	registration, err := registrations.applyPolicy(model.ClientRegistration{
+		RedirectURIs:              []string{"https://client.cisauth.org/callback"},
+		Scope:                     "openid api",
+		Trusted:                   true,
+		LoginRememberForSeconds:   3600,
+		ConsentRememberForSeconds: 3600,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registration.GrantTypes) != 1 || registration.GrantTypes[0] != grantTypeAuthorizationCode ||
+		len(registration.ResponseTypes) != 1 || registration.ResponseTypes[0] != responseTypeCode ||
+		registration.TokenEndpointAuthMethod != tokenEndpointAuthBasic {
+		t.Fatalf("expected the defaults of RFC 7591, got %+v", registration)
+	}
+	if registration.Trusted || registration.LoginRememberForSeconds != 0 || registration.ConsentRememberForSeconds != 0 {
+		t.Fatalf("expected registered clients not to be trusted or remembered, got %+v", registration)
+	}
+}
+
+func TestApplyPolicyRejectsRegistrationsOutsideThePolicy(t *testing.T) {
+	registrations := NewClientRegistrationService(nil, nil, testRegistrationPolicy)
+	redirectURIs := []string{"https://client.cisauth.org/callback"}
+
+	tests := []struct {
+		name         string
+		registration model.ClientRegistration
+		err          error
+	}{
+		{"grant type", model.ClientRegistration{RedirectURIs: redirectURIs, GrantTypes: []string{grantTypeImplicit}}, ErrInvalidClientMetadata},
+		{"response type", model.ClientRegistration{RedirectURIs: redirectURIs, ResponseTypes: []string{"token"}}, ErrInvalidClientMetadata},
+		{"scope", model.ClientRegistration{RedirectURIs: redirectURIs, Scope: "openid admin"}, ErrInvalidClientMetadata},
+		{"token endpoint auth method", model.ClientRegistration{RedirectURIs: redirectURIs, TokenEndpointAuthMethod: "private_key_jwt"}, ErrInvalidClientMetadata},
+		{"public client credentials", model.ClientRegistration{
+			GrantTypes:              []string{grantTypeClientCredentials},
+			TokenEndpointAuthMethod: tokenEndpointAuthNone,
+		}, ErrInvalidClientMetadata},
+		{"missing redirect uri", model.ClientRegistration{}, ErrInvalidRedirectURI},
+		{"invalid redirect uri", model.ClientRegistration{RedirectURIs: []string{"http://client.cisauth.org/callback"}}, ErrInvalidRedirectURI},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := registrations.applyPolicy(test.registration); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v, got %v", test.err, err)
+			}
+		})
+	}
+
+	if _, err := registrations.applyPolicy(model.ClientRegistration{GrantTypes: []string{grantTypeClientCredentials}}); err != nil {
+		t.Fatalf("expected client credentials clients without redirect uris, got %v", err)
+	}
+}
+
+func TestValidateRedirectURI(t *testing.T) {
+	tests := []struct {
+		redirectURI string
+		valid       bool
+	}{
+		{"https://client.cisauth.org/callback", true},
+		{"https://client.cisauth.org:8443/callback?state=kept", true},
+		{"http://localhost:8080/callback", true},
+		{"http://127.0.0.1/callback", true},
+		{"http://[::1]:8080/callback", true},
+		{"http://client.cisauth.org/callback", false},
+		{"https://client.cisauth.org/callback#fragment", false},
+		{"/callback", false},
+		{"https:///callback", false},
+		{"com.cisauth.app:/callback", false},
+		{"javascript:alert(1)", false},
+	}
+	for _, test := range tests {
+		t.Run(test.redirectURI, func(t *testing.T) {
+			err := validateRedirectURI(test.redirectURI)
+			if test.valid && err != nil {
+				t.Fatalf("expected the redirect uri to be allowed, got %v", err)
+			}
+			if !test.valid && !errors.Is(err, ErrInvalidRedirectURI) {
+				t.Fatalf("expected the redirect uri to be rejected, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRegisterClientUsesUpTheInitialAccessTokenOnlyOnSuccess(t *testing.T) {
+	ctx := context.Background()
+	clientService, registry, _ := newTestClientService(t)
+	failing := NewClientRegistrationService(registry.redisClient, failingClientManager{}, testRegistrationPolicy)
+	registrations := NewClientRegistrationService(registry.redisClient, clientService, testRegistrationPolicy)
+	registration := model.ClientRegistration{ClientName: "partner", RedirectURIs: []string{"https://partner.cisauth.org/callback"}}
+
+	initialAccessToken, _, err := registrations.IssueInitialAccessToken(ctx, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := failing.RegisterClient(ctx, initialAccessToken, registration); !errors.Is(err, ErrClientManagement) {
+		t.Fatalf("expected the registration to fail, got %v", err)
+	}
+	if _, err := registrations.RegisterClient(ctx, initialAccessToken, model.ClientRegistration{}); !errors.Is(err, ErrInvalidRedirectURI) {
+		t.Fatalf("expected the registration to be rejected by policy, got %v", err)
+	}
+
+	registered, err := registrations.RegisterClient(ctx, initialAccessToken, registration)
+	if err != nil {
+		t.Fatalf("expected the initial access token to be usable after failed registrations, got %v", err)
+	}
+	if len(registered.ClientSecret) == 0 || len(registered.RegistrationAccessToken) == 0 {
+		t.Fatal("expected the client secret and registration access token to be returned on registration")
+	}
+	if _, err := registrations.RegisterClient(ctx, initialAccessToken, registration); !errors.Is(err, ErrInvalidInitialAccessToken) {
+		t.Fatalf("expected the initial access token to be used up, got %v", err)
+	}
+
+	client, err := registrations.GetRegisteredClient(ctx, registered.ClientID, registered.RegistrationAccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(client.ClientSecret) != 0 {
+		t.Fatal("expected the client secret not to be readable again")
+	}
+	if _, err := registrations.GetRegisteredClient(ctx, registered.ClientID, "other"); !errors.Is(err, ErrInvalidRegistrationAccessToken) {
+		t.Fatalf("expected another registration access token to be rejected, got %v", err)
+	}
+
+	if err := clientService.DeleteClient(ctx, registered.ClientID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registrations.GetRegisteredClient(ctx, registered.ClientID, registered.RegistrationAccessToken); !errors.Is(err, ErrInvalidRegistrationAccessToken) {
+		t.Fatalf("expected the registration access token to stop working once an admin deleted the client, got %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+// ClientRegistry keeps the oauth2 clients the token service acts for in redis, in sync with the oauth2 server.
+// Client secrets are kept in plain text, the token service authenticates with them at the oauth2 server, so access to
+// redis has to be restricted like access to the secrets of the service config.
+type ClientRegistry struct {
+	redisClient *redis.Client
+}
+
+// Client returns the registered oauth2 client.
+func (r *ClientRegistry) Client(ctx context.Context, clientID string) (config.Client, error) {
+	result, err := r.redisClient.Get(ctx, clientKey(clientID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return config.Client{}, ErrClientNotFound
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch oauth2 client from registry", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+		return config.Client{}, err
+	}
+
+	client := config.Client{}
+	if err := json.Unmarshal([]byte(result), &client); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal oauth2 client of registry", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+		return config.Client{}, err
+	}
+
+	return client, nil
+}
+
+// Save registers the oauth2 client or replaces its registration. The client is managed through the client api from
+// then on, the service config does not replace it anymore.
+func (r *ClientRegistry) Save(ctx context.Context, clientID string, client config.Client) error {
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	clientBytes, _ := json.Marshal(client)
+	pipeline := r.redisClient.TxPipeline()
+	pipeline.Set(ctx, clientKey(clientID), string(clientBytes), 0)
+	pipeline.SRem(ctx, model.RedisSeededClientsKey, clientID)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to store oauth2 client in registry", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+		return err
+	}
+
+	return nil
+}
+
+// Delete removes the oauth2 client from the registry along with the registration access token of clients registered
+// through dynamic client registration.
+func (r *ClientRegistry) Delete(ctx context.Context, clientID string) error {
+	pipeline := r.redisClient.TxPipeline()
+	pipeline.Del(ctx, clientKey(clientID), registrationAccessTokenKey(clientID))
+	pipeline.SRem(ctx, model.RedisSeededClientsKey, clientID)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to delete oauth2 client from registry", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+		return err
+	}
+
+	return nil
+}
+
+// Seed registers the clients of the service config, so existing deployments keep working until their clients are
+// managed through the client api. Clients seeded before are replaced by the service config, which rolls out rotated
+// secrets, clients created or changed through the client api are kept as they are.
+func (r *ClientRegistry) Seed(ctx context.Context, clients map[string]config.Client) error {
+	seeded := 0
+	for clientID, client := range clients {
+		exists, err := r.redisClient.Exists(ctx, clientKey(clientID)).Result()
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to seed oauth2 client registry", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+			return err
+		}
+		fromConfig, err := r.redisClient.SIsMember(ctx, model.RedisSeededClientsKey, clientID).Result()
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to seed oauth2 client registry", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+			return err
+		}
+		if exists != 0 && !fromConfig {
+			slog.InfoContext(ctx, "keeping oauth2 client managed through the client api", slog.String("clientID", clientID))
+			continue
+		}
+
+		// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+		clientBytes, _ := json.Marshal(client)
+		pipeline := r.redisClient.TxPipeline()
+		pipeline.Set(ctx, clientKey(clientID), string(clientBytes), 0)
+		pipeline.SAdd(ctx, model.RedisSeededClientsKey, clientID)
+		if _, err := pipeline.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "unable to seed oauth2 client registry", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+			return err
+		}
+		seeded++
+	}
+
+	slog.InfoContext(ctx, "seeded oauth2 client registry", slog.Int("count", seeded))
+
+	return nil
+}
+
+func clientKey(clientID string) string {
+	return strings.Join([]string{model.RedisClientKeyPrefix, clientID}, ":")
+}
+
+// NewClientRegistry creates a new oauth2 client registry.
+func NewClientRegistry(redisClient *redis.Client) *ClientRegistry {
+	return &ClientRegistry{redisClient: redisClient}
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"token-management-service/config"
+)
+
+func newTestClientRegistry(t *testing.T) *ClientRegistry {
+	t.Helper()
+	redisServer := miniredis.RunT(t)
+	return NewClientRegistry(redis.NewClient(&redis.Options{Addr: redisServer.Addr()}))
+}
+
+func TestSeedReplacesSeededClientsAndKeepsManagedOnes(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestClientRegistry(t)
+
+	if err := registry.Seed(ctx, map[string]config.Client{
+		"seeded":  {Secret: "secret"},
+		"managed": {Secret: "secret"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Save(ctx, "managed", config.Client{Secret: "rotated through the client api"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := registry.Seed(ctx, map[string]config.Client{
+		"seeded":  {Secret: "rotated in the service config"},
+		"managed": {Secret: "secret"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	seeded, err := registry.Client(ctx, "seeded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seeded.Secret != "rotated in the service config" {
+		t.Fatalf("expected the seeded client to be replaced by the service config, got %q", seeded.Secret)
+	}
+	managed, err := registry.Client(ctx, "managed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if managed.Secret != "rotated through the client api" {
+		t.Fatalf("expected the client managed through the client api to be kept, got %q", managed.Secret)
+	}
+}
+
+func TestDeleteRemovesTheClientFromTheRegistry(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestClientRegistry(t)
+
+	if err := registry.Save(ctx, "client", config.Client{Secret: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Delete(ctx, "client"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Client(ctx, "client"); !errors.Is(err, ErrClientNotFound) {
+		t.Fatalf("expected the deleted client not to be found, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/model"
+)
+
+// ListConsentSessions returns the consents a subject granted to oauth2 clients which were not revoked.
+func (o *OAuth2) ListConsentSessions(ctx context.Context, subject string) ([]model.ConsentSession, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.Join([]string{o.appConfig.OAuthServerAdminBaseURL, "oauth2/auth/sessions/consent"}, "/"), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create list consent sessions request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	query := request.URL.Query()
+	query.Add("subject", subject)
+	request.URL.RawQuery = query.Encode()
+
+	slog.InfoContext(ctx, "making oauth2 list consent sessions request")
+	response, err := o.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to make oauth2 list consent sessions request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		slog.ErrorContext(ctx, "unexpected status code returned for list consent sessions request", slog.Int("statusCode", response.StatusCode))
+		return nil, ErrListConsentSessions
+	}
+
+	sessions := make([]model.ConsentSession, 0)
+	if err := json.NewDecoder(response.Body).Decode(&sessions); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal list consent sessions response", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "successfully listed consent sessions", slog.Int("count", len(sessions)))
+
+	return sessions, nil
+}
+
+// GetConsent returns the consent request of the challenge with the client and the requested scope and audience.
+func (o *OAuth2) GetConsent(ctx context.Context, consentChallenge string) (*model.ConsentAcceptResponse, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.Join([]string{o.appConfig.OAuthServerAdminBaseURL, "oauth2/auth/requests/consent"}, "/"), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Unable to create get login consent request", slog.Any(utilconstants.Error, err), slog.String("consentChallenge", consentChallenge))
+		return nil, err
+	}
+
+	query := request.URL.Query()
+	query.Add("consent_challenge", consentChallenge)
+	request.URL.RawQuery = query.Encode()
+
+	slog.InfoContext(ctx, "constructed consent challenge query")
+
+	response, err := o.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to make get consent request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	responseBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to parse get consent response", slog.Any(utilconstants.Error, err), slog.Int("statusCode", response.StatusCode))
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		slog.ErrorContext(ctx, "unexpected status code returned from oauth2 server for get consent request", slog.Int("statusCode", response.StatusCode))
+		return nil, ErrInvalidConsentChallenge
+	}
+
+	var consentAcceptResponse model.ConsentAcceptResponse
+	if err := json.Unmarshal(responseBytes, &consentAcceptResponse); err != nil {
+		slog.ErrorContext(ctx, "unable to marshal get consent response", slog.Any(utilconstants.Error, err), slog.Int("statusCode", response.StatusCode))
+		return nil, err
+	}
+	client, err := o.clients.Client(ctx, consentAcceptResponse.Client.ClientID)
+	if err != nil && !errors.Is(err, ErrClientNotFound) {
+		return nil, err
+	}
+	consentAcceptResponse.Trusted = client.Trusted
+
+	slog.InfoContext(ctx, "successfully parsed get consent response", slog.String("clientID", consentAcceptResponse.Client.ClientID),
+		slog.Bool("skip", consentAcceptResponse.Skip))
+
+	return &consentAcceptResponse, nil
+}
+
+// RejectConsent rejects the consent challenge after the user denied access to the client.
+func (o *OAuth2) RejectConsent(ctx context.Context, consentChallenge string) (*model.AcceptConsentResponse, error) {
+	payloadBytes, err := json.Marshal(model.RejectRequest{
+		Error:            "access_denied",
+		ErrorDescription: "The resource owner denied the request",
+		StatusCode:       http.StatusForbidden,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to marshal reject consent request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, strings.Join([]string{o.appConfig.OAuthServerAdminBaseURL, "oauth2/auth/requests/consent/reject"}, "/"), bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create reject consent request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	query := request.URL.Query()
+	query.Add("consent_challenge", consentChallenge)
+	request.URL.RawQuery = query.Encode()
+
+	slog.InfoContext(ctx, "making oauth2 consent reject request")
+	response, err := o.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to make reject consent request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		slog.ErrorContext(ctx, "received unexpected status code for reject consent request", slog.Int("statusCode", response.StatusCode))
+		return nil, ErrInvalidConsentChallenge
+	}
+
+	var rejectConsentResponse model.AcceptConsentResponse
+	if err := json.NewDecoder(response.Body).Decode(&rejectConsentResponse); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal reject consent response", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "successfully rejected consent")
+
+	return &rejectConsentResponse, nil
+}
+
+// isSubset reports whether every granted value is one of the requested values.
+func isSubset(granted, requested []string) bool {
+	for _, value := range granted {
+		if !slices.Contains(requested, value) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"token-management-service/model"
+)
+
+// newTestConsentOAuth2 creates an OAuth2 whose oauth2 server asks for consent to the openid, email and offline scope
+// of the api audience, the accepted or rejected consent is decoded into the returned pointers.
+func newTestConsentOAuth2(t *testing.T) (*OAuth2, *model.ConsentAcceptInitiateRequest, *model.RejectRequest) {
+	t.Helper()
+	accepted := &model.ConsentAcceptInitiateRequest{}
+	rejected := &model.RejectRequest{}
+	oauth2, _, _ := newTestOAuth2(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("consent_challenge") != "challenge" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Path {
+		case "/admin/oauth2/auth/requests/consent":
+			_ = json.NewEncoder(w).Encode(model.ConsentAcceptResponse{
+				RequestedScope:               []string{"openid", "email", "offline"},
+				RequestedAccessTokenAudience: []string{"api"},
+				Subject:                      "user",
+				Client:                       model.OAuth2Client{ClientID: testClientID},
+			})
+		case "/admin/oauth2/auth/requests/consent/accept":
+			_ = json.NewDecoder(r.Body).Decode(accepted)
+			_ = json.NewEncoder(w).Encode(model.AcceptConsentResponse{RedirectTo: "accepted"})
+		case "/admin/oauth2/auth/requests/consent/reject":
+			_ = json.NewDecoder(r.Body).Decode(rejected)
+			_ = json.NewEncoder(w).Encode(model.AcceptConsentResponse{RedirectTo: "rejected"})
+		}
+	})
+
+	return oauth2, accepted, rejected
+}
+
+func TestAcceptConsentGrantsPartOfTheRequestedScope(t *testing.T) {
+	ctx := context.Background()
+	oauth2, accepted, _ := newTestConsentOAuth2(t)
+
+	response, err := oauth2.AcceptConsent(ctx, "challenge", model.ConsentGrant{
+		Scope:    []string{"openid", "email"},
+		Audience: []string{"api"},
+		Remember: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.RedirectTo != "accepted" {
+		t.Fatalf("expected the redirect of the accepted consent, got %q", response.RedirectTo)
+	}
+	if len(accepted.GrantScope) != 2 || accepted.GrantScope[0] != "openid" || accepted.GrantScope[1] != "email" {
+		t.Fatalf("expected only the granted scope to be accepted, got %v", accepted.GrantScope)
+	}
+	if len(accepted.GrantAccessTokenAudience) != 1 || accepted.GrantAccessTokenAudience[0] != "api" {
+		t.Fatalf("expected the granted audience to be accepted, got %v", accepted.GrantAccessTokenAudience)
+	}
+	if !accepted.Remember || accepted.RememberFor != 3600 {
+		t.Fatalf("expected the consent to be remembered for the client, got %v for %d", accepted.Remember, accepted.RememberFor)
+	}
+}
+
+func TestAcceptConsentRejectsGrantsOutsideOfTheRequest(t *testing.T) {
+	ctx := context.Background()
+	oauth2, accepted, _ := newTestConsentOAuth2(t)
+
+	for name, grant := range map[string]model.ConsentGrant{
+		"scope":    {Scope: []string{"openid", "admin"}, Audience: []string{"api"}},
+		"audience": {Scope: []string{"openid"}, Audience: []string{"billing"}},
+	} {
+		if _, err := oauth2.AcceptConsent(ctx, "challenge", grant); !errors.Is(err, ErrInvalidConsentGrant) {
+			t.Fatalf("expected a grant of an unrequested %s to be rejected, got %v", name, err)
+		}
+	}
+	if accepted.GrantScope != nil {
+		t.Fatal("expected no consent to be accepted")
+	}
+
+	if _, err := oauth2.AcceptConsent(ctx, "unknown", model.ConsentGrant{}); !errors.Is(err, ErrInvalidConsentChallenge) {
+		t.Fatalf("expected an unknown challenge to be rejected, got %v", err)
+	}
+}
+
+func TestRejectConsentDeniesAccess(t *testing.T) {
+	ctx := context.Background()
+	oauth2, _, rejected := newTestConsentOAuth2(t)
+
+	response, err := oauth2.RejectConsent(ctx, "challenge")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.RedirectTo != "rejected" {
+		t.Fatalf("expected the redirect of the rejected consent, got %q", response.RedirectTo)
+	}
+	if rejected.Error != "access_denied" || rejected.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected the consent to be rejected with access_denied, got %+v", rejected)
+	}
+
+	if _, err := oauth2.RejectConsent(ctx, "unknown"); !errors.Is(err, ErrInvalidConsentChallenge) {
+		t.Fatalf("expected an unknown challenge to be rejected, got %v", err)
+	}
+}
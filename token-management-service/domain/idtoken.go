@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/model"
+)
+
+// idTokenLeeway tolerates clock skew between this service and the oauth2 server.
+const idTokenLeeway = 30 * time.Second
+
+// idTokenExpectation is what an id token is expected to carry besides a valid signature.
+type idTokenExpectation struct {
+	ClientID string
+	Nonce    string
+	// Stored is set for the id token kept with a session. It was verified when the code was exchanged and the session
+	// outlives it, so only its signature, issuer and audience are verified and not its expiry.
+	Stored bool
+}
+
+// verifyIDToken verifies the signature of the id token against the cached JWKS of the oauth2 server
+// and validates its issuer, audience, expiry and nonce before returning its claims.
+func (o *OAuth2) verifyIDToken(ctx context.Context, idToken string, expectation idTokenExpectation) (model.IDToken, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+	}
+	if expectation.Stored {
+		options = append(options, jwt.WithoutClaimsValidation())
+	} else {
+		options = append(options, jwt.WithExpirationRequired(), jwt.WithIssuedAt(), jwt.WithLeeway(idTokenLeeway))
+		if len(expectation.ClientID) != 0 {
+			options = append(options, jwt.WithAudience(expectation.ClientID))
+		}
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(idToken, claims, o.keySet.KeyfuncContext(ctx), options...); err != nil {
+		slog.ErrorContext(ctx, "unable to verify id token", slog.Any(utilconstants.Error, err))
+		return model.IDToken{}, ErrInvalidIDToken
+	}
+
+	// claims validation is skipped for stored id tokens, which still have to be issued to the client.
+	if expectation.Stored && len(expectation.ClientID) != 0 {
+		if audience, err := claims.GetAudience(); err != nil || !slices.Contains(audience, expectation.ClientID) {
+			slog.ErrorContext(ctx, "id token issued to unexpected audience", slog.Any("audience", audience))
+			return model.IDToken{}, ErrInvalidIDToken
+		}
+	}
+
+	issuer, err := claims.GetIssuer()
+	if err != nil || strings.TrimSuffix(issuer, "/") != strings.TrimSuffix(o.appConfig.OAuthServerIssuerURL, "/") {
+		slog.ErrorContext(ctx, "id token issued by unexpected issuer", slog.String("issuer", issuer))
+		return model.IDToken{}, ErrInvalidIDToken
+	}
+
+	if len(expectation.Nonce) != 0 {
+		if nonce, _ := claims["nonce"].(string); nonce != expectation.Nonce {
+			slog.ErrorContext(ctx, "id token nonce does not match")
+			return model.IDToken{}, ErrInvalidIDToken
+		}
+	}
+
+	var idTokenClaims model.IDToken
+	// Suppressing marshal errors since claims were just decoded from json.
+	idTokenClaimsBytes, _ := json.Marshal(claims)
+	if err := json.Unmarshal(idTokenClaimsBytes, &idTokenClaims); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal id token claims", slog.Any(utilconstants.Error, err))
+		return model.IDToken{}, err
+	}
+
+	slog.InfoContext(ctx, "successfully verified id token")
+
+	return idTokenClaims, nil
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/model"
+)
+
+// GetLoginRequest returns the login request of the challenge. The oauth2 server decides whether the login is skipped,
+// it does not skip logins for prompt=login or an exceeded max_age and answers prompt=none itself.
+func (o *OAuth2) GetLoginRequest(ctx context.Context, loginChallenge string) (*model.LoginRequest, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.Join([]string{o.appConfig.OAuthServerAdminBaseURL, "oauth2/auth/requests/login"}, "/"), nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create get login request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	query := request.URL.Query()
+	query.Add("login_challenge", loginChallenge)
+	request.URL.RawQuery = query.Encode()
+
+	slog.InfoContext(ctx, "making oauth2 get login request")
+	response, err := o.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to make get login request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		slog.ErrorContext(ctx, "unexpected status code returned for get login request", slog.Int("statusCode", response.StatusCode))
+		return nil, loginChallengeError(response.StatusCode)
+	}
+
+	var loginRequest model.LoginRequest
+	if err := json.NewDecoder(response.Body).Decode(&loginRequest); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal get login response", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "successfully parsed get login response", slog.String("clientID", loginRequest.Client.ClientID),
+		slog.Bool("skip", loginRequest.Skip))
+
+	return &loginRequest, nil
+}
+
+// rememberLogin keeps how the user of a remembered login session authenticated for as long as the session is
+// remembered by the oauth2 server.
+func (o *OAuth2) rememberLogin(ctx context.Context, sessionID string, remembered model.RememberedLogin, rememberFor time.Duration) {
+	if len(sessionID) == 0 {
+		return
+	}
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	rememberedBytes, _ := json.Marshal(remembered)
+	if err := o.redisClient.Set(ctx, rememberedLoginKey(sessionID), rememberedBytes, rememberFor).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store remembered login", slog.Any(utilconstants.Error, err))
+	}
+}
+
+// rememberedLogin returns how the user of a remembered login session authenticated, nil when it is not known.
+func (o *OAuth2) rememberedLogin(ctx context.Context, sessionID string) *model.RememberedLogin {
+	if len(sessionID) == 0 {
+		return nil
+	}
+	rememberedBytes, err := o.redisClient.Get(ctx, rememberedLoginKey(sessionID)).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			slog.ErrorContext(ctx, "unable to fetch remembered login", slog.Any(utilconstants.Error, err))
+		}
+		return nil
+	}
+
+	remembered := model.RememberedLogin{}
+	if err := json.Unmarshal(rememberedBytes, &remembered); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal remembered login", slog.Any(utilconstants.Error, err))
+		return nil
+	}
+
+	return &remembered
+}
+
+func rememberedLoginKey(sessionID string) string {
+	return strings.Join([]string{model.RedisRememberedLoginKeyPrefix, sessionID}, ":")
+}
+
+// loginChallengeError tells challenges the oauth2 server does not know anymore apart from already handled ones.
+func loginChallengeError(statusCode int) error {
+	switch statusCode {
+	case http.StatusGone:
+		return ErrLoginChallengeUsed
+	case http.StatusBadRequest, http.StatusNotFound:
+		return ErrLoginChallengeExpired
+	}
+	return ErrInvalidLoginChallenge
+}
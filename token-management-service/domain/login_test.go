@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+func TestAcceptCarriesTheAuthenticationOverToSkippedLogins(t *testing.T) {
+	ctx := context.Background()
+	accepted := make([]model.LoginAcceptRequest, 0)
+	oauth2, _, _ := newTestOAuth2(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/oauth2/auth/requests/login":
+			_ = json.NewEncoder(w).Encode(model.LoginRequest{
+				Skip:      r.URL.Query().Get("login_challenge") == "skipped",
+				SessionID: "login-session",
+				Client:    model.OAuth2Client{ClientID: testClientID},
+			})
+		case "/admin/oauth2/auth/requests/login/accept":
+			acceptLoginRequest := model.LoginAcceptRequest{}
+			_ = json.NewDecoder(r.Body).Decode(&acceptLoginRequest)
+			accepted = append(accepted, acceptLoginRequest)
+			_ = json.NewEncoder(w).Encode(model.AcceptLoginResponse{RedirectTo: "accepted"})
+		}
+	})
+	if err := oauth2.clients.Save(ctx, testClientID, config.Client{Secret: "secret", LoginRememberForSeconds: 3600}); err != nil {
+		t.Fatal(err)
+	}
+	userID, otherUserID := uuid.New(), uuid.New()
+	userProfile := models.UserProfile{ID: &userID}
+
+	if _, err := oauth2.Accept(ctx, "login", userProfile, model.LoginAuthentication{
+		Acr:      constants.ACRMultiFactor,
+		Amr:      []string{constants.AMRPassword, constants.AMRMultiFactor},
+		Remember: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !accepted[0].Remember || accepted[0].RememberFor != 3600 {
+		t.Fatalf("expected the login to be remembered as configured for the client, got %+v", accepted[0])
+	}
+
+	if _, err := oauth2.Accept(ctx, "skipped", userProfile, model.LoginAuthentication{Acr: constants.ACRSingleFactor}); err != nil {
+		t.Fatal(err)
+	}
+	if accepted[1].Remember || accepted[1].Acr != constants.ACRMultiFactor || len(accepted[1].Amr) != 2 {
+		t.Fatalf("expected the skipped login to be accepted with the remembered authentication, got %+v", accepted[1])
+	}
+
+	if _, err := oauth2.Accept(ctx, "skipped", models.UserProfile{ID: &otherUserID}, model.LoginAuthentication{Acr: constants.ACRSingleFactor}); err != nil {
+		t.Fatal(err)
+	}
+	if accepted[2].Acr != constants.ACRSingleFactor || len(accepted[2].Amr) != 0 {
+		t.Fatalf("expected the authentication of another user not to be carried over, got %+v", accepted[2])
+	}
+}
+
+func TestGetLoginRequestLeavesPromptAndMaxAgeToTheOAuth2Server(t *testing.T) {
+	ctx := context.Background()
+	oauth2, _, _ := newTestOAuth2(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("login_challenge") {
+		case "used":
+			w.WriteHeader(http.StatusGone)
+		case "expired":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"skip":        true,
+				"subject":     "user",
+				"client":      model.OAuth2Client{ClientID: testClientID},
+				"request_url": "https://cisauth.org/oauth2/auth?prompt=login&max_age=0",
+			})
+		}
+	})
+
+	loginRequest, err := oauth2.GetLoginRequest(ctx, "login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loginRequest.Skip || loginRequest.Subject != "user" {
+		t.Fatalf("expected the login request as decided by the oauth2 server, got %+v", loginRequest)
+	}
+
+	if _, err := oauth2.GetLoginRequest(ctx, "used"); !errors.Is(err, ErrLoginChallengeUsed) {
+		t.Fatalf("expected a used login challenge, got %v", err)
+	}
+	if _, err := oauth2.GetLoginRequest(ctx, "expired"); !errors.Is(err, ErrLoginChallengeExpired) {
+		t.Fatalf("expected an expired login challenge, got %v", err)
+	}
+}
@@ -14,12 +14,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/adjust/rmq/v5"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
 	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
 
 	"token-management-service/config"
@@ -29,8 +30,14 @@ import (
 var (
 	// ErrInvalidLoginChallenge for login challenge issues.
 	ErrInvalidLoginChallenge = errors.New("invalid login challenge")
+	// ErrLoginChallengeExpired when the oauth2 server does not know the login challenge or it expired.
+	ErrLoginChallengeExpired = errors.New("login challenge is unknown or expired")
+	// ErrLoginChallengeUsed when the login request of the challenge was already accepted or rejected.
+	ErrLoginChallengeUsed = errors.New("login challenge was already used")
 	// ErrInvalidConsentChallenge for invalid consent challenge code.
 	ErrInvalidConsentChallenge = errors.New("invalid consent challenge code")
+	// ErrInvalidConsentGrant when the granted scope or audience was not requested by the client.
+	ErrInvalidConsentGrant = errors.New("granted scope or audience was not requested")
 	// ErrTokenExchangeBadRequest when OAuth2 server returns bad request for token exchange.
 	ErrTokenExchangeBadRequest = errors.New("bad token exchange request")
 	// ErrUnauthorisedTokenExchange when OAuth2 server returns unauthorised for token exchange.
@@ -49,6 +56,36 @@ var (
 	ErrAccessTokenExpired = errors.New("either access token expired or does not exist")
 	// ErrInvalidIDToken when token format is invalid.
 	ErrInvalidIDToken = errors.New("idToken is invalid")
+	// ErrSessionCompromised when a rotated-out refresh token of the session is reused.
+	ErrSessionCompromised = errors.New("session compromised")
+	// ErrRevokeSessions when OAuth2 server fails to revoke login or consent sessions.
+	ErrRevokeSessions = errors.New("unable to revoke login sessions")
+	// ErrListConsentSessions when OAuth2 server fails to list the consent sessions of a subject.
+	ErrListConsentSessions = errors.New("unable to list consent sessions")
+	// ErrClientNotFound when the oauth2 client is not registered.
+	ErrClientNotFound = errors.New("oauth2 client not found")
+	// ErrInvalidClientMetadata when OAuth2 server rejects the metadata of an oauth2 client.
+	ErrInvalidClientMetadata = errors.New("invalid oauth2 client metadata")
+	// ErrClientManagement when OAuth2 server fails to create, update or delete an oauth2 client.
+	ErrClientManagement = errors.New("unable to manage oauth2 client")
+	// ErrInvalidRedirectURI when a redirect uri of a client registration is not allowed by the registration policy.
+	ErrInvalidRedirectURI = errors.New("invalid redirect uri")
+	// ErrInvalidInitialAccessToken when a client registration has no valid initial access token.
+	ErrInvalidInitialAccessToken = errors.New("invalid initial access token")
+	// ErrInvalidRegistrationAccessToken when the registration access token does not belong to the registered client.
+	ErrInvalidRegistrationAccessToken = errors.New("invalid registration access token")
+	// ErrTokenIntrospection when OAuth2 server fails to introspect a token.
+	ErrTokenIntrospection = errors.New("unable to introspect token")
+	// ErrInvalidSubjectToken when the subject token of a token exchange is not an active user access token.
+	ErrInvalidSubjectToken = errors.New("invalid subject token")
+	// ErrInvalidActorToken when the actor token of a token exchange is not an active token of an internal service.
+	ErrInvalidActorToken = errors.New("invalid actor token")
+	// ErrAudienceNotAllowed when the acting service may not exchange tokens for the requested audience.
+	ErrAudienceNotAllowed = errors.New("token exchange for audience is not allowed")
+	// ErrInvalidExchangeScope when the requested scope is empty or was not granted to the subject token.
+	ErrInvalidExchangeScope = errors.New("invalid token exchange scope")
+	// ErrTokenExchangeDisabled when no token exchange is configured.
+	ErrTokenExchangeDisabled = errors.New("token exchange is not configured")
 )
 
 var (
@@ -64,7 +101,6 @@ var (
 
 const (
 	refreshTokenExpiry = 1 // in hours
-	oauthKey           = "hydra.openid.id-token"
 
 	// reused const's
 	grantType   = "grant_type"
@@ -77,8 +113,11 @@ const (
 
 // Auth provides abstraction for OAuth2 authentication flow operations.
 type Auth interface {
-	Accept(ctx context.Context, loginChallenge string, UserProfile models.UserProfile) (*model.AcceptLoginResponse, error)
-	AcceptConsent(ctx context.Context, consentChallenge string) (*model.AcceptConsentResponse, error)
+	Accept(ctx context.Context, loginChallenge string, UserProfile models.UserProfile, authentication model.LoginAuthentication) (*model.AcceptLoginResponse, error)
+	GetLoginRequest(ctx context.Context, loginChallenge string) (*model.LoginRequest, error)
+	AcceptConsent(ctx context.Context, consentChallenge string, grant model.ConsentGrant) (*model.AcceptConsentResponse, error)
+	GetConsent(ctx context.Context, consentChallenge string) (*model.ConsentAcceptResponse, error)
+	RejectConsent(ctx context.Context, consentChallenge string) (*model.AcceptConsentResponse, error)
 	ExchangeToken(ctx context.Context, tokenExchangeRequest model.TokenExchangeRequest) (*model.TokenExchangeResponse, error)
 	IntrospectToken(ctx context.Context, accessToken, sessionID string, tokenType model.TokenType) (*model.IntrospectResponse, error)
 	IntrospectResponse(ctx context.Context, accessToken string, tokenType model.TokenType) (*model.IntrospectVerificationResponse, error)
@@ -86,6 +125,12 @@ type Auth interface {
 	AccessForClientToken(ctx context.Context, email, clientID string) (*model.ClientTokenResponse, error)
 	FetchRefreshToken(ctx context.Context, accessToken, sessionID string) (*model.TokenExchangeResponse, error)
 	RevokeAccessToken(ctx context.Context, accessToken, sessionID, clientID string) error
+	RevokeLoginSessions(ctx context.Context, subject string) error
+	ListSessions(ctx context.Context, userID string) ([]model.SessionMetadata, error)
+	IsSessionToken(ctx context.Context, sessionID, accessToken string) bool
+	RevokeSession(ctx context.Context, userID, publicID string) error
+	RevokeAllSessions(ctx context.Context, userID string) error
+	ListConsentSessions(ctx context.Context, subject string) ([]model.ConsentSession, error)
 }
 
 // OAuth2 model for oauth2 dependencies.
@@ -93,17 +138,43 @@ type OAuth2 struct {
 	httpClient  *http.Client
 	redisClient *redis.Client
 	appConfig   *config.App
+	emailQueue  rmq.Queue
+	keySet      *jwks.Cache
+	clients     *ClientRegistry
 }
 
-// Accept calls OAuth2 admin login accept.
-func (o *OAuth2) Accept(ctx context.Context, loginChallenge string, userProfile models.UserProfile) (*model.AcceptLoginResponse, error) {
+// Accept calls OAuth2 admin login accept, the acr and amr tell the oauth2 server how the user authenticated.
+// A login the user asked to be remembered for is kept as long as configured for the client of the login request,
+// skipped logins of the remembered login session are accepted with the acr and amr of the login which started it.
+func (o *OAuth2) Accept(ctx context.Context, loginChallenge string, userProfile models.UserProfile, authentication model.LoginAuthentication) (*model.AcceptLoginResponse, error) {
+	loginRequest, err := o.GetLoginRequest(ctx, loginChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	acr := authentication.Acr
+	if len(acr) == 0 {
+		acr = utilconstants.ACRSingleFactor
+	}
 	acceptLoginRequest := model.LoginAcceptRequest{
 		Subject:     userProfile.ID.String(),
-		Remember:    false,
-		RememberFor: 0,
-		Acr:         "1",
+		Acr:         acr,
+		Amr:         authentication.Amr,
 		Userprofile: userProfile,
 	}
+	if loginRequest.Skip {
+		if remembered := o.rememberedLogin(ctx, loginRequest.SessionID); remembered != nil && remembered.Subject == acceptLoginRequest.Subject {
+			acceptLoginRequest.Acr, acceptLoginRequest.Amr = remembered.Acr, remembered.Amr
+		}
+	} else if authentication.Remember {
+		client, err := o.clients.Client(ctx, loginRequest.Client.ClientID)
+		if err != nil && !errors.Is(err, ErrClientNotFound) {
+			return nil, err
+		}
+		rememberFor := client.LoginRememberForSeconds
+		acceptLoginRequest.Remember = rememberFor > 0
+		acceptLoginRequest.RememberFor = rememberFor
+	}
 
 	queryParams := make(map[string]string)
 	queryParams["login_challenge"] = loginChallenge
@@ -145,6 +216,10 @@ func (o *OAuth2) Accept(ctx context.Context, loginChallenge string, userProfile
 		return nil, err
 	}
 
+	if response.StatusCode == http.StatusGone {
+		slog.ErrorContext(ctx, "login challenge of acceptLoginRequest was already used")
+		return nil, ErrLoginChallengeUsed
+	}
 	if response.StatusCode == http.StatusUnauthorized ||
 		response.StatusCode == http.StatusNotFound ||
 		response.StatusCode == http.StatusInternalServerError {
@@ -163,60 +238,42 @@ func (o *OAuth2) Accept(ctx context.Context, loginChallenge string, userProfile
 
 	slog.InfoContext(ctx, "successfully returned acceptLoginResponse")
 
+	if acceptLoginRequest.Remember {
+		o.rememberLogin(ctx, loginRequest.SessionID, model.RememberedLogin{
+			Subject: acceptLoginRequest.Subject,
+			Acr:     acceptLoginRequest.Acr,
+			Amr:     acceptLoginRequest.Amr,
+		}, time.Duration(acceptLoginRequest.RememberFor)*time.Second)
+	}
+
 	return &acceptLoginResponse, nil
 }
 
-// AcceptConsent calls OAuth2 consent and accept consent.
-func (o *OAuth2) AcceptConsent(ctx context.Context, consentChallenge string) (*model.AcceptConsentResponse, error) {
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.Join([]string{o.appConfig.OAuthServerAdminBaseURL, "oauth2/auth/requests/consent"}, "/"), nil)
+// AcceptConsent accepts the consent challenge with the scopes and audience granted by the user,
+// which have to be part of the requested ones. Remembered consents are not prompted for again.
+func (o *OAuth2) AcceptConsent(ctx context.Context, consentChallenge string, grant model.ConsentGrant) (*model.AcceptConsentResponse, error) {
+	consentAcceptResponse, err := o.GetConsent(ctx, consentChallenge)
 	if err != nil {
-		slog.ErrorContext(ctx, "Unable to create get login consent request", slog.Any(utilconstants.Error, err), slog.String("consentChallenge", consentChallenge))
 		return nil, err
 	}
-
-	query := request.URL.Query()
-	query.Add("consent_challenge", consentChallenge)
-	encodedQuery := query.Encode()
-	request.URL.RawQuery = encodedQuery
-
-	slog.InfoContext(ctx, "constructed consent challenge query")
-
-	response, err := o.httpClient.Do(request)
-	if err != nil {
-		slog.ErrorContext(ctx, "unable to make get consent request", slog.Any(utilconstants.Error, err))
-		return nil, err
+	if !isSubset(grant.Scope, consentAcceptResponse.RequestedScope) || !isSubset(grant.Audience, consentAcceptResponse.RequestedAccessTokenAudience) {
+		slog.ErrorContext(ctx, "consent grant is not part of the requested scope or audience", slog.Any("grant", grant))
+		return nil, ErrInvalidConsentGrant
 	}
-
-	defer response.Body.Close()
-
-	responseBytes, err := io.ReadAll(response.Body)
-	if err != nil {
-		slog.ErrorContext(ctx, "unable to parse get consent response", slog.Any(utilconstants.Error, err), slog.Int("statusCode", response.StatusCode))
+	client, err := o.clients.Client(ctx, consentAcceptResponse.Client.ClientID)
+	if err != nil && !errors.Is(err, ErrClientNotFound) {
 		return nil, err
 	}
-
-	if response.StatusCode >= http.StatusMultipleChoices {
-		slog.ErrorContext(ctx, "unexpected status code returned from oauth2 server for get consent request", slog.Int("statusCode", response.StatusCode))
-		return nil, ErrInvalidConsentChallenge
-	}
-
-	var (
-		consentAcceptResponse model.ConsentAcceptResponse
-	)
-	if err := json.Unmarshal(responseBytes, &consentAcceptResponse); err != nil {
-		slog.ErrorContext(ctx, "unable to marshal get consent response", slog.Any(utilconstants.Error, err), slog.Int("statusCode", response.StatusCode), slog.String("consentResponse", string(responseBytes)))
-		return nil, err
-	}
-
-	slog.InfoContext(ctx, "successfully parsed get consent response", slog.Any("consentResponse", consentAcceptResponse))
+	consentRememberFor := client.ConsentRememberForSeconds
 
 	consentAcceptInitiateRequest := model.ConsentAcceptInitiateRequest{
-		GrantAccessTokenAudience: consentAcceptResponse.RequestedAccessTokenAudience,
-		GrantScope:               consentAcceptResponse.RequestedScope,
-		Remember:                 true,
-		RememberFor:              1,
+		GrantAccessTokenAudience: grant.Audience,
+		GrantScope:               grant.Scope,
+		Remember:                 grant.Remember && consentRememberFor > 0,
+		RememberFor:              consentRememberFor,
 		Session: model.Session{
-			Userprofile: consentAcceptResponse.Userprofile,
+			Userprofile:        consentAcceptResponse.Userprofile,
+			AccessTokenProfile: consentAcceptResponse.Userprofile,
 		},
 	}
 
@@ -234,7 +291,7 @@ func (o *OAuth2) AcceptConsent(ctx context.Context, consentChallenge string) (*m
 		return nil, err
 	}
 
-	query = consentAcceptRequest.URL.Query()
+	query := consentAcceptRequest.URL.Query()
 	query.Add("consent_challenge", consentChallenge)
 	acceptConsentEncodedQuery := query.Encode()
 	consentAcceptRequest.URL.RawQuery = acceptConsentEncodedQuery
@@ -297,6 +354,24 @@ func (o *OAuth2) ExchangeToken(ctx context.Context, tokenExchangeRequest model.T
 
 	slog.InfoContext(ctx, "successfully exchanged token")
 
+	// the id token is verified before the session is stored, tokens of a rejected exchange are revoked since no
+	// session is left to revoke them with.
+	var userID string
+	if len(tokenResponse.IDToken) != 0 {
+		userInfo, err := o.verifyIDToken(ctx, tokenResponse.IDToken, idTokenExpectation{
+			ClientID: tokenExchangeRequest.ClientID,
+			Nonce:    tokenExchangeRequest.Nonce,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to verify id token", slog.Any(utilconstants.Error, err))
+			o.revokeExchangedTokens(ctx, tokenResponse, tokenExchangeRequest.ClientID)
+			return nil, err
+		}
+		if userInfo.ID != nil {
+			userID = userInfo.ID.String()
+		}
+	}
+
 	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
 	tokenResponseBytes, _ := json.Marshal(tokenResponse)
 	// RefreshToken expiry
@@ -308,9 +383,21 @@ func (o *OAuth2) ExchangeToken(ctx context.Context, tokenExchangeRequest model.T
 	redisCmd := o.redisClient.Set(ctx, id, string(tokenResponseBytes), refreshTokenExpireAt)
 	if _, err := redisCmd.Result(); err != nil {
 		slog.ErrorContext(ctx, "unable to store session in redis cache", slog.Any(utilconstants.Error, err))
+		o.revokeExchangedTokens(ctx, tokenResponse, tokenExchangeRequest.ClientID)
 		return nil, err
 	}
 
+	if len(userID) != 0 {
+		if err := o.indexSession(ctx, id, userID, tokenExchangeRequest); err != nil {
+			o.unindexSession(ctx, id)
+			if err := o.redisClient.Del(ctx, id).Err(); err != nil {
+				slog.ErrorContext(ctx, "unable to delete unindexed session", slog.Any(utilconstants.Error, err))
+			}
+			o.revokeExchangedTokens(ctx, tokenResponse, tokenExchangeRequest.ClientID)
+			return nil, err
+		}
+	}
+
 	slog.InfoContext(ctx, "successfully exchanged token!")
 
 	return tokenResponse, nil
@@ -378,6 +465,10 @@ func (o *OAuth2) IntrospectToken(ctx context.Context, token, sessionID string, t
 	}
 
 	if !(tokenResponse.AccessToken == token || tokenResponse.RefreshToken == token) {
+		if o.isSupersededRefreshToken(ctx, sessionID, token) {
+			o.revokeCompromisedSession(ctx, sessionID, tokenResponse)
+			return nil, ErrSessionCompromised
+		}
 		return nil, ErrSessionNotFound
 	}
 
@@ -446,7 +537,7 @@ func (o *OAuth2) IntrospectToken(ctx context.Context, token, sessionID string, t
 		}
 		return nil, ErrSessionExpired
 	}
-	userInfo, err := o.decodeIdTokenFromJWT(ctx, tokenResponse.IDToken)
+	userInfo, err := o.verifyIDToken(ctx, tokenResponse.IDToken, idTokenExpectation{ClientID: introspectResponse.ClientId, Stored: true})
 	if err != nil {
 		slog.ErrorContext(ctx, "unable to parse user information from jwt", slog.Any(utilconstants.Error, err))
 		return nil, err
@@ -553,7 +644,11 @@ func (o *OAuth2) clientCredentials(ctx context.Context, clientID, email string)
 
 // AccessForRefreshToken refresh token rotation.
 func (o *OAuth2) AccessForRefreshToken(ctx context.Context, refreshToken, actualClientID, existingSessionID string) (*model.TokenExchangeResponse, error) {
-	redirectURI := o.appConfig.Clients[actualClientID].RedirectURI
+	client, err := o.clients.Client(ctx, actualClientID)
+	if err != nil {
+		return nil, err
+	}
+	redirectURI := client.RedirectURI
 	data := url.Values{
 		grantType:       []string{model.RefreshToken},
 		clientID:        []string{actualClientID},
@@ -580,12 +675,21 @@ func (o *OAuth2) AccessForRefreshToken(ctx context.Context, refreshToken, actual
 	}
 	slog.InfoContext(ctx, "successfully set token in session")
 
+	if tokenResponse.RefreshToken != refreshToken {
+		o.recordSupersededRefreshToken(ctx, existingSessionID, refreshToken)
+	}
+	o.touchSession(ctx, existingSessionID)
+
 	return tokenResponse, nil
 }
 
 func (o *OAuth2) generateToken(ctx context.Context, data url.Values, clientID string) (*model.ClientTokenResponse, error) {
+	client, err := o.clients.Client(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
 	username := clientID
-	password := o.appConfig.Clients[clientID].Secret
+	password := client.Secret
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.Join([]string{o.appConfig.OAuthServerPublicBaseURL, "oauth2/token"}, "/"), strings.NewReader(data.Encode()))
 	if err != nil {
@@ -637,8 +741,11 @@ func (o *OAuth2) exchangeToken(ctx context.Context, data url.Values) (*model.Tok
 	request.Header.Set(contentType, "application/x-www-form-urlencoded")
 
 	actualClientID := data.Get(clientID)
-	clientSecret := o.appConfig.Clients[actualClientID].Secret
-	request.SetBasicAuth(actualClientID, clientSecret)
+	client, err := o.clients.Client(ctx, actualClientID)
+	if err != nil {
+		return nil, err
+	}
+	request.SetBasicAuth(actualClientID, client.Secret)
 
 	slog.InfoContext(ctx, "making exchange token request")
 	response, err := o.httpClient.Do(request)
@@ -744,25 +851,116 @@ func (o *OAuth2) FetchRefreshToken(ctx context.Context, accessToken, sessionID s
 	return newToken, nil
 }
 
-// RevokeAccessToken revokes the access token.
+// RevokeAccessToken revokes the access token and the refresh token of the session. The session is only deleted when
+// the access token belongs to it, since the session id is taken from the request and must not remove other keys. Of
+// concurrent revocations of a session only one succeeds, the others fail with ErrSessionNotFound.
 func (o *OAuth2) RevokeAccessToken(ctx context.Context, accessToken, sessionID, clientID string) error {
-	slog.InfoContext(ctx, "deleting token details from redis")
-	redisCmd := o.redisClient.Del(ctx, sessionID)
-	if _, err := redisCmd.Result(); err != nil {
-		slog.ErrorContext(ctx, "unable to delete session in redis", slog.String("sessionID", sessionID), slog.String("clientID", clientID))
+	if len(accessToken) == 0 {
+		return ErrSessionNotFound
+	}
+
+	tokens := []string{accessToken}
+	tokenResponse, err := o.getTokenResponse(ctx, sessionID)
+	switch {
+	case errors.Is(err, redis.Nil):
+		slog.InfoContext(ctx, "session already expired, revoking the access token only", slog.String("clientID", clientID))
+	case err != nil:
 		return err
+	case tokenResponse.AccessToken != accessToken:
+		slog.ErrorContext(ctx, "access token does not belong to the session to revoke", slog.String("clientID", clientID))
+		return ErrSessionNotFound
+	default:
+		if len(tokenResponse.RefreshToken) != 0 {
+			tokens = append(tokens, tokenResponse.RefreshToken)
+		}
+
+		o.unindexSession(ctx, sessionID)
+
+		slog.InfoContext(ctx, "deleting token details from redis")
+		pipeline := o.redisClient.TxPipeline()
+		deleted := pipeline.Del(ctx, sessionID)
+		pipeline.Del(ctx, refreshTokenHistoryKey(sessionID))
+		if _, err := pipeline.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "unable to delete session in redis", slog.String("sessionID", sessionID), slog.String("clientID", clientID))
+			return err
+		}
+		// only one of concurrent revocations deletes the session, single use tokens can't be used twice.
+		if deleted.Val() == 0 {
+			slog.ErrorContext(ctx, "session was revoked concurrently", slog.String("clientID", clientID))
+			return ErrSessionNotFound
+		}
+		slog.InfoContext(ctx, "successfully deleted token from redis")
 	}
-	slog.InfoContext(ctx, "successfully deleted token from redis")
 
+	for _, t := range tokens {
+		if err := o.revokeToken(ctx, t, clientID); err != nil {
+			slog.ErrorContext(ctx, "unable to revoke token for session id", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID), slog.String("clientID", clientID))
+			return err
+		}
+	}
+	slog.InfoContext(ctx, "successfully revoked tokens for session id", slog.String("sessionID", sessionID), slog.String("clientID", clientID))
+
+	return nil
+}
+
+// RevokeLoginSessions revokes the oauth2 server login and consent sessions of the subject,
+// so the next authorization request prompts for login and consent again.
+func (o *OAuth2) RevokeLoginSessions(ctx context.Context, subject string) error {
+	endpoints := []string{"oauth2/auth/sessions/login", "oauth2/auth/sessions/consent"}
+	for _, endpoint := range endpoints {
+		request, err := http.NewRequestWithContext(ctx, http.MethodDelete, strings.Join([]string{o.appConfig.OAuthServerAdminBaseURL, endpoint}, "/"), nil)
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to create revoke sessions request", slog.Any(utilconstants.Error, err), slog.String("endpoint", endpoint))
+			return err
+		}
+		query := request.URL.Query()
+		query.Add("subject", subject)
+		query.Add("all", "true")
+		request.URL.RawQuery = query.Encode()
+
+		slog.InfoContext(ctx, "making oauth2 revoke sessions request", slog.String("endpoint", endpoint))
+		response, err := o.httpClient.Do(request)
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to make oauth2 revoke sessions request", slog.Any(utilconstants.Error, err), slog.String("endpoint", endpoint))
+			return err
+		}
+		response.Body.Close()
+
+		if response.StatusCode >= http.StatusMultipleChoices && response.StatusCode != http.StatusNotFound {
+			slog.ErrorContext(ctx, "unexpected status code returned for revoke sessions request", slog.Int("statusCode", response.StatusCode), slog.String("endpoint", endpoint))
+			return ErrRevokeSessions
+		}
+	}
+
+	slog.InfoContext(ctx, "successfully revoked login and consent sessions")
+
+	return nil
+}
+
+// revokeExchangedTokens revokes the tokens of an exchange which did not result in a session.
+func (o *OAuth2) revokeExchangedTokens(ctx context.Context, tokenResponse *model.TokenExchangeResponse, clientID string) {
+	for _, t := range []string{tokenResponse.AccessToken, tokenResponse.RefreshToken} {
+		if len(t) == 0 {
+			continue
+		}
+		if err := o.revokeToken(ctx, t, clientID); err != nil {
+			slog.ErrorContext(ctx, "unable to revoke tokens of failed exchange", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
+		}
+	}
+}
+
+func (o *OAuth2) revokeToken(ctx context.Context, tokenToRevoke, clientID string) error {
+	client, err := o.clients.Client(ctx, clientID)
+	if err != nil {
+		return err
+	}
 	username := clientID
-	password := o.appConfig.Clients[clientID].Secret
+	password := client.Secret
 
 	data := url.Values{
-		token: []string{accessToken},
+		token: []string{tokenToRevoke},
 	}
 
-	slog.InfoContext(ctx, "form data for revoke token request", slog.Any("data", data), slog.String("sessionID", sessionID), slog.String("clientID", clientID))
-
 	request, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.Join([]string{o.appConfig.OAuthServerPublicBaseURL, "oauth2/revoke"}, "/"), strings.NewReader(data.Encode()))
 	if err != nil {
 		slog.ErrorContext(ctx, "unable to create new request for oauth2 revoke token", slog.Any(utilconstants.Error, err))
@@ -772,24 +970,24 @@ func (o *OAuth2) RevokeAccessToken(ctx context.Context, accessToken, sessionID,
 	request.SetBasicAuth(username, password)
 	request.Header.Set(contentType, "application/x-www-form-urlencoded")
 
-	slog.InfoContext(ctx, "making oauth2 revoke access token api call")
+	slog.InfoContext(ctx, "making oauth2 revoke token api call")
 	response, err := o.httpClient.Do(request)
 	if err != nil {
-		slog.ErrorContext(ctx, "unable to make oauth2 revoke token request", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID), slog.String("clientID", clientID))
+		slog.ErrorContext(ctx, "unable to make oauth2 revoke token request", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
 		return err
 	}
+	defer response.Body.Close()
+
 	if response.StatusCode == http.StatusOK {
-		slog.InfoContext(ctx, "successfully revoked access token for session id", slog.String("sessionID", sessionID), slog.String("clientID", clientID))
 		return nil
 	}
 
-	slog.ErrorContext(ctx, "status code is other than 200 returned from oauth2 during revoke token", slog.Any(utilconstants.Error, err), slog.Int("statusCode", response.StatusCode))
+	slog.ErrorContext(ctx, "status code is other than 200 returned from oauth2 during revoke token", slog.Int("statusCode", response.StatusCode))
 
 	if _, err := io.ReadAll(response.Body); err != nil {
-		slog.ErrorContext(ctx, "unable to read response body for oauth2 revoke token", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID), slog.String("clientID", clientID))
+		slog.ErrorContext(ctx, "unable to read response body for oauth2 revoke token", slog.Any(utilconstants.Error, err), slog.String("clientID", clientID))
 		return err
 	}
-	defer response.Body.Close()
 	if response.StatusCode > http.StatusMultipleChoices {
 		return exchangeErrorMap[response.StatusCode]
 	}
@@ -805,53 +1003,13 @@ func sessionId() string {
 }
 
 // NewOAuth2 creates a new object for OAuth2.
-func NewOAuth2(client *http.Client, redisClient *redis.Client, app *config.App) *OAuth2 {
-	return &OAuth2{httpClient: client, redisClient: redisClient, appConfig: app}
-}
-
-func (o *OAuth2) decodeIdTokenFromJWT(ctx context.Context, idToken string) (model.IDToken, error) {
-	// Parse the JWT token string without providing a key for signature verification
-	token, _, err := new(jwt.Parser).ParseUnverified(idToken, jwt.MapClaims{})
-	if err != nil {
-		slog.ErrorContext(ctx, "unable to parse unverified id token claims from id token", slog.Any(utilconstants.Error, err))
-		return model.IDToken{}, err
-	}
-	var idTokenClaims model.IDToken
-
-	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
-	idTokenClaimsBytes, err := json.Marshal(token.Claims)
-	if err != nil {
-		slog.ErrorContext(ctx, "unable to marshal token claims", slog.Any(utilconstants.Error, err))
-		return model.IDToken{}, err
+func NewOAuth2(client *http.Client, redisClient *redis.Client, app *config.App, emailQueue rmq.Queue, clients *ClientRegistry) *OAuth2 {
+	return &OAuth2{
+		httpClient:  client,
+		redisClient: redisClient,
+		appConfig:   app,
+		emailQueue:  emailQueue,
+		keySet:      jwks.NewCache(client, strings.Join([]string{app.OAuthServerPublicBaseURL, ".well-known/jwks.json"}, "/")),
+		clients:     clients,
 	}
-	if err := json.Unmarshal(idTokenClaimsBytes, &idTokenClaims); err != nil {
-		return model.IDToken{}, err
-	}
-	val, ok := token.Header["kid"].(string)
-	if !ok {
-		slog.ErrorContext(ctx, "failed to fet kid from id token claims headers")
-		return model.IDToken{}, errors.New("failed to get kid")
-	}
-
-	// check integrity of token data (redis) with oauth2 server
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.Join([]string{o.appConfig.OAuthServerAdminBaseURL, "keys", oauthKey, val}, "/"), nil)
-	if err != nil {
-		slog.ErrorContext(ctx, "unable to create id token validation request", slog.Any(utilconstants.Error, err))
-		return model.IDToken{}, err
-	}
-
-	response, err := o.httpClient.Do(request)
-	if err != nil {
-		slog.ErrorContext(ctx, "unable to make id token validation request", slog.Any(utilconstants.Error, err))
-		return model.IDToken{}, err
-	}
-
-	if response.StatusCode == http.StatusOK {
-		slog.InfoContext(ctx, "successfully validated id token")
-		return idTokenClaims, nil
-	}
-
-	slog.ErrorContext(ctx, "unexpected non 200 status code returned from id token validation", slog.Int("statusCode", response.StatusCode), slog.Any(utilconstants.Error, ErrInvalidIDToken))
-
-	return model.IDToken{}, ErrInvalidIDToken
 }
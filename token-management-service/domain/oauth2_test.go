@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adjust/rmq/v5"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+const testClientID = "client"
+
+// newTestOAuth2 creates an OAuth2 backed by miniredis and an oauth2 server answering with the handler, the test
+// client is registered with the client registry.
+func newTestOAuth2(t *testing.T, handler http.HandlerFunc) (*OAuth2, *miniredis.Miniredis, rmq.TestConnection) {
+	t.Helper()
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	oauth2Server := httptest.NewServer(handler)
+	t.Cleanup(oauth2Server.Close)
+
+	clients := NewClientRegistry(redisClient)
+	if err := clients.Save(context.Background(), testClientID, config.Client{Secret: "secret", ConsentRememberForSeconds: 3600}); err != nil {
+		t.Fatal(err)
+	}
+
+	connection := rmq.NewTestConnection()
+	emailQueue, _ := connection.OpenQueue("email")
+	app := &config.App{
+		OAuthServerPublicBaseURL: oauth2Server.URL,
+		OAuthServerAdminBaseURL:  oauth2Server.URL + "/admin",
+		OAuthServerIssuerURL:     oauth2Server.URL,
+	}
+
+	return NewOAuth2(oauth2Server.Client(), redisClient, app, emailQueue, clients), redisServer, connection
+}
+
+// storeSession stores the tokens of a session like a code exchange does.
+func storeSession(t *testing.T, redisServer *miniredis.Miniredis, sessionID string, tokens model.TokenExchangeResponse) {
+	t.Helper()
+	tokenBytes, _ := json.Marshal(tokens)
+	if err := redisServer.Set(sessionID, string(tokenBytes)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRevokeAccessTokenOnlyDeletesTheSessionOfTheToken(t *testing.T) {
+	ctx := context.Background()
+	revoked := make([]string, 0)
+	oauth2, redisServer, _ := newTestOAuth2(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/revoke" {
+			_ = r.ParseForm()
+			revoked = append(revoked, r.PostForm.Get(token))
+		}
+	})
+	storeSession(t, redisServer, "session", model.TokenExchangeResponse{AccessToken: "access", RefreshToken: "refresh"})
+
+	if err := oauth2.RevokeAccessToken(ctx, "other", "session", testClientID); !errors.Is(err, ErrSessionNotFound) {
+		t.Fatalf("expected the session of another access token to be rejected, got %v", err)
+	}
+	if err := oauth2.RevokeAccessToken(ctx, "access", clientKey(testClientID), testClientID); !errors.Is(err, ErrSessionNotFound) {
+		t.Fatalf("expected a key which is no session to be rejected, got %v", err)
+	}
+	if !redisServer.Exists("session") || !redisServer.Exists(clientKey(testClientID)) {
+		t.Fatal("expected rejected revocations to keep their keys")
+	}
+	if len(revoked) != 0 {
+		t.Fatalf("expected rejected revocations to revoke nothing, revoked %v", revoked)
+	}
+
+	if err := oauth2.RevokeAccessToken(ctx, "access", "session", testClientID); err != nil {
+		t.Fatal(err)
+	}
+	if redisServer.Exists("session") {
+		t.Fatal("expected the session to be deleted")
+	}
+	if len(revoked) != 2 || revoked[0] != "access" || revoked[1] != "refresh" {
+		t.Fatalf("expected the access and refresh token to be revoked, revoked %v", revoked)
+	}
+}
+
+func TestExchangeTokenStoresNothingForARejectedIDToken(t *testing.T) {
+	ctx := context.Background()
+	revoked := make([]string, 0)
+	oauth2, redisServer, _ := newTestOAuth2(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/jwks.json":
+			_ = json.NewEncoder(w).Encode(jwks.KeySet{})
+		case "/oauth2/token":
+			_ = json.NewEncoder(w).Encode(model.TokenExchangeResponse{AccessToken: "access", RefreshToken: "refresh", IDToken: "id", ExpiresIn: 3600})
+		case "/oauth2/revoke":
+			_ = r.ParseForm()
+			revoked = append(revoked, r.PostForm.Get(token))
+		}
+	})
+	keys := redisServer.Keys()
+
+	if _, err := oauth2.ExchangeToken(ctx, model.TokenExchangeRequest{Code: "code", ClientID: testClientID}); err == nil {
+		t.Fatal("expected an id token which cannot be verified to be rejected")
+	}
+	if len(redisServer.Keys()) != len(keys) {
+		t.Fatalf("expected no session to be stored, keys %v", redisServer.Keys())
+	}
+	if len(revoked) != 2 || revoked[0] != "access" || revoked[1] != "refresh" {
+		t.Fatalf("expected the access and refresh token to be revoked, revoked %v", revoked)
+	}
+}
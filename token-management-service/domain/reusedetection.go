@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/model"
+)
+
+// recordSupersededRefreshToken keeps the hash of a refresh token that was rotated out of a session.
+func (o *OAuth2) recordSupersededRefreshToken(ctx context.Context, sessionID, refreshToken string) {
+	pipeline := o.redisClient.TxPipeline()
+	pipeline.SAdd(ctx, refreshTokenHistoryKey(sessionID), hashToken(refreshToken))
+	pipeline.Expire(ctx, refreshTokenHistoryKey(sessionID), time.Hour*refreshTokenExpiry)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to record superseded refresh token", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID))
+	}
+}
+
+// isSupersededRefreshToken reports whether the token was rotated out of the session earlier.
+func (o *OAuth2) isSupersededRefreshToken(ctx context.Context, sessionID, token string) bool {
+	reused, err := o.redisClient.SIsMember(ctx, refreshTokenHistoryKey(sessionID), hashToken(token)).Result()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to check refresh token history", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID))
+		return false
+	}
+
+	return reused
+}
+
+// revokeCompromisedSession revokes the whole token family of a session in which a rotated-out
+// refresh token was reused and notifies the user about it.
+func (o *OAuth2) revokeCompromisedSession(ctx context.Context, sessionID string, tokenResponse *model.TokenExchangeResponse) {
+	slog.ErrorContext(ctx, "refresh token reuse detected, revoking session", slog.String("sessionID", sessionID))
+
+	metadata, err := o.getSessionMetadata(ctx, sessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch metadata of compromised session", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID))
+		metadata = &model.SessionMetadata{SessionID: sessionID}
+	}
+
+	if len(metadata.ClientID) != 0 {
+		if err := o.RevokeAccessToken(ctx, tokenResponse.AccessToken, sessionID, metadata.ClientID); err != nil {
+			slog.ErrorContext(ctx, "unable to revoke tokens of compromised session", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID))
+		}
+	}
+	if err := o.redisClient.Del(ctx, sessionID, refreshTokenHistoryKey(sessionID)).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to delete compromised session", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID))
+	}
+
+	if len(tokenResponse.IDToken) == 0 {
+		return
+	}
+	// the id token may have expired by now, its signature is what matters to trust the email.
+	userInfo, err := o.verifyIDToken(ctx, tokenResponse.IDToken, idTokenExpectation{
+		ClientID: metadata.ClientID,
+		Stored:   true,
+	})
+	if err != nil || len(userInfo.Email) == 0 {
+		slog.ErrorContext(ctx, "unable to resolve user of compromised session", slog.Any(utilconstants.Error, err), slog.String("sessionID", sessionID))
+		return
+	}
+
+	o.publishSecurityAlert(ctx, userInfo.Email, model.SecurityAlertPayload{
+		Reason:     model.RefreshTokenReuseReason,
+		UserAgent:  metadata.UserAgent,
+		IPAddress:  metadata.IPAddress,
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+func (o *OAuth2) publishSecurityAlert(ctx context.Context, email string, payload model.SecurityAlertPayload) {
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	payloadBytes, _ := json.Marshal(payload)
+	eventBytes, _ := json.Marshal(model.Event{
+		Email:        email,
+		Type:         model.SecurityAlertEvent,
+		EventPayload: payloadBytes,
+	})
+
+	if err := o.emailQueue.PublishBytes(eventBytes); err != nil {
+		slog.ErrorContext(ctx, "unable to publish security alert event", slog.Any(utilconstants.Error, err))
+		return
+	}
+
+	slog.InfoContext(ctx, "published security alert event", slog.String("reason", payload.Reason))
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func refreshTokenHistoryKey(sessionID string) string {
+	return strings.Join([]string{model.RedisRefreshTokenHistoryKeyPrefix, sessionID}, ":")
+}
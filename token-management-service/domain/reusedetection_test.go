@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+
+	"token-management-service/model"
+)
+
+func TestReusedRefreshTokenRevokesSessionAndAlertsUser(t *testing.T) {
+	ctx := context.Background()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked := make([]string, 0)
+	oauth2, redisServer, connection := newTestOAuth2(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/jwks.json":
+			_ = json.NewEncoder(w).Encode(jwks.KeySet{Keys: []jwks.JSONWebKey{{
+				KeyID:   "key",
+				KeyType: "RSA",
+				N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}}})
+		case "/oauth2/token":
+			_ = json.NewEncoder(w).Encode(model.TokenExchangeResponse{AccessToken: "access-2", RefreshToken: "refresh-2", ExpiresIn: 3600})
+		case "/oauth2/revoke":
+			_ = r.ParseForm()
+			revoked = append(revoked, r.PostForm.Get(token))
+		}
+	})
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   oauth2.appConfig.OAuthServerIssuerURL,
+		"aud":   testClientID,
+		"sub":   "user",
+		"email": "user@cisauth.org",
+		"iat":   time.Now().Add(-3 * time.Hour).Unix(),
+		"exp":   time.Now().Add(-2 * time.Hour).Unix(),
+	})
+	idToken.Header["kid"] = "key"
+	signedIDToken, err := idToken.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeSession(t, redisServer, "session", model.TokenExchangeResponse{AccessToken: "access-1", RefreshToken: "refresh-1", IDToken: signedIDToken})
+	if err := oauth2.indexSession(ctx, "session", "user", model.TokenExchangeRequest{ClientID: testClientID, IPAddress: "10.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := oauth2.AccessForRefreshToken(ctx, "refresh-1", testClientID, "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.RefreshToken != "refresh-2" || !oauth2.isSupersededRefreshToken(ctx, "session", "refresh-1") {
+		t.Fatal("expected the rotated-out refresh token to be recorded")
+	}
+	if oauth2.isSupersededRefreshToken(ctx, "session", "refresh-2") {
+		t.Fatal("expected the current refresh token not to be superseded")
+	}
+
+	// the rotation dropped the id token, store it again like a refresh with the openid scope does.
+	storeSession(t, redisServer, "session", model.TokenExchangeResponse{AccessToken: "access-2", RefreshToken: "refresh-2", IDToken: signedIDToken})
+	if _, err := oauth2.IntrospectToken(ctx, "refresh-1", "session", model.RefreshToken); !errors.Is(err, ErrSessionCompromised) {
+		t.Fatalf("expected reuse of the rotated-out refresh token to compromise the session, got %v", err)
+	}
+
+	if redisServer.Exists("session") || redisServer.Exists(refreshTokenHistoryKey("session")) {
+		t.Fatal("expected the compromised session and its refresh token history to be deleted")
+	}
+	if len(revoked) != 2 || revoked[0] != "access-2" || revoked[1] != "refresh-2" {
+		t.Fatalf("expected the current tokens of the session to be revoked, revoked %v", revoked)
+	}
+
+	deliveries := connection.GetDeliveries("email")
+	if len(deliveries) != 1 {
+		t.Fatalf("expected one security alert, got %d", len(deliveries))
+	}
+	event := model.Event{}
+	if err := json.Unmarshal([]byte(deliveries[0]), &event); err != nil {
+		t.Fatal(err)
+	}
+	payload := model.SecurityAlertPayload{}
+	if err := json.Unmarshal(event.EventPayload, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != model.SecurityAlertEvent || event.Email != "user@cisauth.org" ||
+		payload.Reason != model.RefreshTokenReuseReason || payload.IPAddress != "10.0.0.1" {
+		t.Fatalf("unexpected security alert %+v %+v", event, payload)
+	}
+}
@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"token-management-service/model"
+)
+
+// ListSessions returns the active sessions of a user.
+func (o *OAuth2) ListSessions(ctx context.Context, userID string) ([]model.SessionMetadata, error) {
+	slog.InfoContext(ctx, "fetching sessions of user from redis")
+	sessionIDs, err := o.redisClient.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch sessions of user from redis", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	sessions := make([]model.SessionMetadata, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		metadata, err := o.getSessionMetadata(ctx, sessionID)
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				// session expired on its own, drop it from the index.
+				o.redisClient.SRem(ctx, userSessionsKey(userID), sessionID)
+				continue
+			}
+			return nil, err
+		}
+		sessions = append(sessions, *metadata)
+	}
+
+	slog.InfoContext(ctx, "successfully fetched sessions of user", slog.Int("count", len(sessions)))
+
+	return sessions, nil
+}
+
+// IsSessionToken reports whether the access token is the current access token of the session, a session id alone
+// does not tell which session a request belongs to.
+func (o *OAuth2) IsSessionToken(ctx context.Context, sessionID, accessToken string) bool {
+	if len(sessionID) == 0 || len(accessToken) == 0 {
+		return false
+	}
+	tokenResponse, err := o.getTokenResponse(ctx, sessionID)
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(tokenResponse.AccessToken), []byte(accessToken)) == 1
+}
+
+// RevokeSession revokes a single session of a user identified by its public id.
+func (o *OAuth2) RevokeSession(ctx context.Context, userID, publicID string) error {
+	sessions, err := o.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.PublicID == publicID {
+			return o.revokeSession(ctx, session)
+		}
+	}
+
+	slog.ErrorContext(ctx, "session to revoke not found for user", slog.String("publicID", publicID))
+	return ErrSessionNotFound
+}
+
+// RevokeAllSessions revokes every session of a user.
+func (o *OAuth2) RevokeAllSessions(ctx context.Context, userID string) error {
+	sessions, err := o.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if err := o.revokeSession(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	slog.InfoContext(ctx, "successfully revoked all sessions of user", slog.Int("count", len(sessions)))
+
+	return nil
+}
+
+func (o *OAuth2) revokeSession(ctx context.Context, session model.SessionMetadata) error {
+	tokenResponse, err := o.getTokenResponse(ctx, session.SessionID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			o.unindexSession(ctx, session.SessionID)
+			return nil
+		}
+		return err
+	}
+
+	return o.RevokeAccessToken(ctx, tokenResponse.AccessToken, session.SessionID, session.ClientID)
+}
+
+// indexSession stores the metadata of a new session and adds it to the session index of the user.
+func (o *OAuth2) indexSession(ctx context.Context, sessionID, userID string, request model.TokenExchangeRequest) error {
+	now := time.Now().UTC()
+	metadata := model.SessionMetadata{
+		SessionID:  sessionID,
+		PublicID:   publicSessionID(sessionID),
+		UserID:     userID,
+		ClientID:   request.ClientID,
+		UserAgent:  request.UserAgent,
+		IPAddress:  request.IPAddress,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+	if err := o.setSessionMetadata(ctx, metadata); err != nil {
+		return err
+	}
+
+	refreshTokenExpireAt := time.Hour * refreshTokenExpiry
+	pipeline := o.redisClient.TxPipeline()
+	pipeline.SAdd(ctx, userSessionsKey(userID), sessionID)
+	pipeline.Expire(ctx, userSessionsKey(userID), refreshTokenExpireAt)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to add session to user session index", slog.Any(utilconstants.Error, err))
+		return err
+	}
+
+	return nil
+}
+
+// touchSession updates the last used time of a session and extends its index expiry.
+func (o *OAuth2) touchSession(ctx context.Context, sessionID string) {
+	metadata, err := o.getSessionMetadata(ctx, sessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch session metadata", slog.Any(utilconstants.Error, err))
+		return
+	}
+
+	metadata.LastUsedAt = time.Now().UTC()
+	if err := o.setSessionMetadata(ctx, *metadata); err != nil {
+		return
+	}
+	o.redisClient.Expire(ctx, userSessionsKey(metadata.UserID), time.Hour*refreshTokenExpiry)
+}
+
+// unindexSession removes a session from the session index of its user.
+func (o *OAuth2) unindexSession(ctx context.Context, sessionID string) {
+	metadata, err := o.getSessionMetadata(ctx, sessionID)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			slog.ErrorContext(ctx, "unable to fetch session metadata", slog.Any(utilconstants.Error, err))
+		}
+		return
+	}
+
+	pipeline := o.redisClient.TxPipeline()
+	pipeline.SRem(ctx, userSessionsKey(metadata.UserID), sessionID)
+	pipeline.Del(ctx, sessionMetadataKey(sessionID))
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to remove session from user session index", slog.Any(utilconstants.Error, err))
+	}
+}
+
+func (o *OAuth2) getSessionMetadata(ctx context.Context, sessionID string) (*model.SessionMetadata, error) {
+	result, err := o.redisClient.Get(ctx, sessionMetadataKey(sessionID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := model.SessionMetadata{}
+	if err := json.Unmarshal([]byte(result), &metadata); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal session metadata", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+func (o *OAuth2) setSessionMetadata(ctx context.Context, metadata model.SessionMetadata) error {
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	metadataBytes, _ := json.Marshal(metadata)
+	if err := o.redisClient.Set(ctx, sessionMetadataKey(metadata.SessionID), string(metadataBytes), time.Hour*refreshTokenExpiry).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store session metadata in redis", slog.Any(utilconstants.Error, err))
+		return err
+	}
+
+	return nil
+}
+
+func publicSessionID(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return base64.RawURLEncoding.EncodeToString(sum[:16])
+}
+
+func sessionMetadataKey(sessionID string) string {
+	return strings.Join([]string{model.RedisSessionMetadataKeyPrefix, sessionID}, ":")
+}
+
+func userSessionsKey(userID string) string {
+	return strings.Join([]string{model.RedisUserSessionsKeyPrefix, userID}, ":")
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"token-management-service/model"
+)
+
+func TestSessionIndexListsAndRevokesSessionsOfUser(t *testing.T) {
+	ctx := context.Background()
+	oauth2, redisServer, _ := newTestOAuth2(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	for _, sessionID := range []string{"first", "second", "expired"} {
+		storeSession(t, redisServer, sessionID, model.TokenExchangeResponse{AccessToken: sessionID + "-access"})
+		if err := oauth2.indexSession(ctx, sessionID, "user", model.TokenExchangeRequest{ClientID: testClientID, UserAgent: "browser"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	storeSession(t, redisServer, "another", model.TokenExchangeResponse{AccessToken: "another-access"})
+	if err := oauth2.indexSession(ctx, "another", "other-user", model.TokenExchangeRequest{ClientID: testClientID}); err != nil {
+		t.Fatal(err)
+	}
+	redisServer.Del(sessionMetadataKey("expired"))
+
+	sessions, err := oauth2.ListSessions(ctx, "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("expected the two live sessions of the user, got %d", len(sessions))
+	}
+	if isMember, _ := redisServer.SIsMember(userSessionsKey("user"), "expired"); isMember {
+		t.Fatal("expected the expired session to be dropped from the index")
+	}
+	for _, session := range sessions {
+		if session.PublicID == session.SessionID || session.PublicID != publicSessionID(session.SessionID) {
+			t.Fatal("expected sessions to be listed with a public id which is not the session id")
+		}
+		if session.UserAgent != "browser" || session.ClientID != testClientID {
+			t.Fatalf("expected session metadata to be kept, got %+v", session)
+		}
+	}
+
+	if !oauth2.IsSessionToken(ctx, "first", "first-access") {
+		t.Fatal("expected the access token of the session to belong to it")
+	}
+	if oauth2.IsSessionToken(ctx, "first", "second-access") || oauth2.IsSessionToken(ctx, "first", "") {
+		t.Fatal("expected the access token of another session not to belong to it")
+	}
+
+	if err := oauth2.RevokeSession(ctx, "user", publicSessionID("another")); !errors.Is(err, ErrSessionNotFound) {
+		t.Fatalf("expected the session of another user to be not found, got %v", err)
+	}
+	if err := oauth2.RevokeSession(ctx, "user", publicSessionID("first")); err != nil {
+		t.Fatal(err)
+	}
+	if redisServer.Exists("first") || !redisServer.Exists("second") {
+		t.Fatal("expected only the revoked session to be deleted")
+	}
+
+	if err := oauth2.RevokeAllSessions(ctx, "user"); err != nil {
+		t.Fatal(err)
+	}
+	if sessions, _ := oauth2.ListSessions(ctx, "user"); len(sessions) != 0 {
+		t.Fatalf("expected no sessions after revoking all, got %d", len(sessions))
+	}
+	if !redisServer.Exists("another") {
+		t.Fatal("expected the sessions of other users to be kept")
+	}
+}
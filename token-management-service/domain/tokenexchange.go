@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"context"
+	"crypto"
+	"crypto/elliptic"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	utilconstants "github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+// TokenExchanger provides abstraction for exchanging user access tokens for down-scoped access tokens of internal
+// services (RFC 8693).
+type TokenExchanger interface {
+	ExchangeForAudience(ctx context.Context, request model.AudienceExchangeRequest) (*model.ExchangedToken, error)
+	KeySet() (jwks.KeySet, error)
+}
+
+// TokenExchangeService issues short-lived access tokens which let an internal service call another one on behalf of
+// a user, restricted to a single audience and to a part of the scope of the user access token. Without a token
+// exchange config no tokens are exchanged.
+type TokenExchangeService struct {
+	httpClient    *http.Client
+	appConfig     *config.App
+	signingKey    crypto.Signer
+	signingMethod jwt.SigningMethod
+}
+
+// exchangedTokenClaims are the claims of an exchanged access token. They are laid out like the JWT access tokens of
+// the oauth2 server, so resource servers validate both alike, with the acting service in the act claim.
+type exchangedTokenClaims struct {
+	jwt.RegisteredClaims
+	ClientID string             `json:"client_id"`
+	Scope    []string           `json:"scp"`
+	Ext      models.UserProfile `json:"ext"`
+	Act      model.Actor        `json:"act"`
+}
+
+// ExchangeForAudience exchanges the access token of a user for an access token of the requested audience and scope.
+// The acting service proves itself with its client credentials access token and may only ask for the audiences it
+// is configured for. The exchanged token never outlives the user access token.
+func (s *TokenExchangeService) ExchangeForAudience(ctx context.Context, request model.AudienceExchangeRequest) (*model.ExchangedToken, error) {
+	if s.signingKey == nil {
+		return nil, ErrTokenExchangeDisabled
+	}
+
+	actor, err := s.introspect(ctx, request.ActorToken)
+	if err != nil {
+		return nil, err
+	}
+	// client credentials access tokens are issued with the client as subject.
+	if !actor.Active || actor.TokenUse != model.AccessToken || actor.Sub != actor.ClientId {
+		slog.InfoContext(ctx, "actor token is not an active client credentials access token")
+		return nil, ErrInvalidActorToken
+	}
+
+	policy, ok := s.appConfig.TokenExchange.Actors[actor.ClientId]
+	if !ok || !slices.Contains(policy.Audiences, request.Audience) {
+		slog.InfoContext(ctx, "token exchange for audience is not allowed", slog.String("actor", actor.ClientId),
+			slog.String("audience", request.Audience))
+		return nil, ErrAudienceNotAllowed
+	}
+
+	subject, err := s.introspect(ctx, request.SubjectToken)
+	if err != nil {
+		return nil, err
+	}
+	if !subject.Active || subject.TokenUse != model.AccessToken || subject.Ext.ID == nil {
+		slog.InfoContext(ctx, "subject token is not an active user access token", slog.String("actor", actor.ClientId))
+		return nil, ErrInvalidSubjectToken
+	}
+
+	if len(request.Scope) == 0 {
+		return nil, ErrInvalidExchangeScope
+	}
+	grantedScope := strings.Fields(subject.Scope)
+	for _, scope := range request.Scope {
+		if !slices.Contains(grantedScope, scope) {
+			slog.InfoContext(ctx, "requested scope was not granted to the subject token", slog.String("scope", scope))
+			return nil, ErrInvalidExchangeScope
+		}
+	}
+
+	expiresIn := time.Duration(s.appConfig.TokenExchange.MaxTTLSeconds) * time.Second
+	if request.ExpiresIn > 0 && request.ExpiresIn < expiresIn {
+		expiresIn = request.ExpiresIn
+	}
+	expiresIn = min(expiresIn, time.Until(time.Unix(subject.Exp, 0))).Truncate(time.Second)
+	if expiresIn <= 0 {
+		return nil, ErrInvalidSubjectToken
+	}
+
+	now := time.Now().UTC()
+	token := jwt.NewWithClaims(s.signingMethod, exchangedTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.appConfig.TokenExchange.Issuer,
+			Subject:   subject.Sub,
+			Audience:  jwt.ClaimStrings{request.Audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiresIn)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.NewString(),
+		},
+		ClientID: actor.ClientId,
+		Scope:    request.Scope,
+		Ext:      subject.Ext,
+		Act:      model.Actor{Sub: actor.ClientId},
+	})
+	token.Header["kid"] = s.appConfig.TokenExchange.KeyID
+
+	accessToken, err := token.SignedString(s.signingKey)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to sign exchanged access token", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "exchanged access token for audience", slog.String("actor", actor.ClientId),
+		slog.String("audience", request.Audience), slog.Duration("expiresIn", expiresIn))
+
+	return &model.ExchangedToken{
+		AccessToken:     accessToken,
+		IssuedTokenType: model.IssuedTokenTypeAccessToken,
+		TokenType:       model.TokenTypeBearer,
+		ExpiresIn:       int64(expiresIn.Seconds()),
+		Scope:           request.Scope,
+	}, nil
+}
+
+// KeySet returns the public key exchanged tokens are verified with, the key set is empty without a token exchange
+// config.
+func (s *TokenExchangeService) KeySet() (jwks.KeySet, error) {
+	keySet := jwks.KeySet{Keys: []jwks.JSONWebKey{}}
+	if s.signingKey == nil {
+		return keySet, nil
+	}
+
+	key, err := jwks.NewJSONWebKey(s.appConfig.TokenExchange.KeyID, s.signingKey.Public())
+	if err != nil {
+		return jwks.KeySet{}, err
+	}
+	keySet.Keys = append(keySet.Keys, key)
+
+	return keySet, nil
+}
+
+// introspect introspects the token with the oauth2 server, inactive tokens are returned without an error.
+func (s *TokenExchangeService) introspect(ctx context.Context, tokenToIntrospect string) (*model.IntrospectResponse, error) {
+	data := url.Values{
+		token: []string{tokenToIntrospect},
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.Join([]string{s.appConfig.OAuthServerAdminBaseURL, "oauth2/introspect"}, "/"), strings.NewReader(data.Encode()))
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create introspection request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	request.Header.Set(contentType, "application/x-www-form-urlencoded")
+	request.Header.Set(accept, "application/json")
+
+	slog.InfoContext(ctx, "making oauth2 introspection request for token exchange")
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to make oauth2 introspection request", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		slog.ErrorContext(ctx, "unexpected status code returned for introspection request", slog.Int("statusCode", response.StatusCode))
+		return nil, ErrTokenIntrospection
+	}
+
+	introspectResponse := &model.IntrospectResponse{}
+	if err := json.NewDecoder(response.Body).Decode(introspectResponse); err != nil {
+		slog.ErrorContext(ctx, "unable to unmarshal introspection response body", slog.Any(utilconstants.Error, err))
+		return nil, err
+	}
+
+	return introspectResponse, nil
+}
+
+// parseSigningKey parses the PEM encoded private key exchanged tokens are signed with, EC keys sign with ES256 and
+// RSA keys with RS256.
+func parseSigningKey(pemKey string) (crypto.Signer, jwt.SigningMethod, error) {
+	if ecKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(pemKey)); err == nil {
+		if ecKey.Curve != elliptic.P256() {
+			return nil, nil, errors.New("token exchange signing key must use the P-256 curve")
+		}
+		return ecKey, jwt.SigningMethodES256, nil
+	}
+
+	rsaKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(pemKey))
+	if err != nil {
+		return nil, nil, errors.New("token exchange signing key is neither an EC nor an RSA private key")
+	}
+
+	return rsaKey, jwt.SigningMethodRS256, nil
+}
+
+// NewTokenExchangeService creates a new object for TokenExchangeService, the signing key has to be resolved from the
+// secrets beforehand.
+func NewTokenExchangeService(client *http.Client, app *config.App) (*TokenExchangeService, error) {
+	if app.TokenExchange == nil {
+		return &TokenExchangeService{httpClient: client, appConfig: app}, nil
+	}
+
+	signingKey, signingMethod, err := parseSigningKey(app.TokenExchange.SigningKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenExchangeService{
+		httpClient:    client,
+		appConfig:     app,
+		signingKey:    signingKey,
+		signingMethod: signingMethod,
+	}, nil
+}
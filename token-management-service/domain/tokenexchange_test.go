@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+
+	"token-management-service/config"
+	"token-management-service/model"
+)
+
+const testExchangeIssuer = "urn:cisauth:token-exchange"
+
+// newTestTokenExchange creates a TokenExchangeService with a generated signing key and an oauth2 server introspecting
+// the given tokens, unknown tokens are inactive.
+func newTestTokenExchange(t *testing.T, tokens map[string]model.IntrospectResponse) *TokenExchangeService {
+	t.Helper()
+	oauth2Server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/oauth2/introspect" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		_ = json.NewEncoder(w).Encode(tokens[r.PostForm.Get(token)])
+	}))
+	t.Cleanup(oauth2Server.Close)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenExchange, err := NewTokenExchangeService(oauth2Server.Client(), &config.App{
+		OAuthServerAdminBaseURL: oauth2Server.URL + "/admin",
+		TokenExchange: &config.TokenExchange{
+			Issuer:        testExchangeIssuer,
+			KeyID:         "exchange",
+			SigningKey:    string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})),
+			MaxTTLSeconds: 300,
+			Actors: map[string]config.TokenExchangeActor{
+				"billing-service": {Audiences: []string{"invoice-service"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tokenExchange
+}
+
+func testExchangeTokens(subjectExpiresIn time.Duration) map[string]model.IntrospectResponse {
+	userID := uuid.New()
+	return map[string]model.IntrospectResponse{
+		"actor": {
+			Active: true, TokenUse: model.AccessToken, Sub: "billing-service", ClientId: "billing-service",
+			Exp: time.Now().Add(time.Hour).Unix(),
+		},
+		"other-actor": {
+			Active: true, TokenUse: model.AccessToken, Sub: "report-service", ClientId: "report-service",
+			Exp: time.Now().Add(time.Hour).Unix(),
+		},
+		"user-as-actor": {
+			Active: true, TokenUse: model.AccessToken, Sub: userID.String(), ClientId: "billing-service",
+			Ext: models.UserProfile{ID: &userID}, Exp: time.Now().Add(time.Hour).Unix(),
+		},
+		"subject": {
+			Active: true, TokenUse: model.AccessToken, Sub: userID.String(), ClientId: "web",
+			Ext: models.UserProfile{ID: &userID}, Scope: "openid invoices:read invoices:write",
+			Exp: time.Now().Add(subjectExpiresIn).Unix(),
+		},
+		"client-subject": {
+			Active: true, TokenUse: model.AccessToken, Sub: "web", ClientId: "web", Scope: "invoices:read",
+			Exp: time.Now().Add(time.Hour).Unix(),
+		},
+	}
+}
+
+func TestExchangeForAudienceChecksTheActorAudienceAndScope(t *testing.T) {
+	ctx := context.Background()
+	tokenExchange := newTestTokenExchange(t, testExchangeTokens(time.Hour))
+	valid := model.AudienceExchangeRequest{
+		SubjectToken: "subject",
+		ActorToken:   "actor",
+		Audience:     "invoice-service",
+		Scope:        []string{"invoices:read"},
+	}
+
+	tests := []struct {
+		name   string
+		change func(request *model.AudienceExchangeRequest)
+		err    error
+	}{
+		{"inactive actor", func(request *model.AudienceExchangeRequest) { request.ActorToken = "unknown" }, ErrInvalidActorToken},
+		{"user token as actor", func(request *model.AudienceExchangeRequest) { request.ActorToken = "user-as-actor" }, ErrInvalidActorToken},
+		{"actor without policy", func(request *model.AudienceExchangeRequest) { request.ActorToken = "other-actor" }, ErrAudienceNotAllowed},
+		{"audience not allowed", func(request *model.AudienceExchangeRequest) { request.Audience = "user-service" }, ErrAudienceNotAllowed},
+		{"inactive subject", func(request *model.AudienceExchangeRequest) { request.SubjectToken = "unknown" }, ErrInvalidSubjectToken},
+		{"client as subject", func(request *model.AudienceExchangeRequest) { request.SubjectToken = "client-subject" }, ErrInvalidSubjectToken},
+		{"empty scope", func(request *model.AudienceExchangeRequest) { request.Scope = nil }, ErrInvalidExchangeScope},
+		{"scope not granted", func(request *model.AudienceExchangeRequest) { request.Scope = []string{"invoices:delete"} }, ErrInvalidExchangeScope},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := valid
+			test.change(&request)
+			if _, err := tokenExchange.ExchangeForAudience(ctx, request); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v, got %v", test.err, err)
+			}
+		})
+	}
+
+	exchanged, err := tokenExchange.ExchangeForAudience(ctx, valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keySet, err := tokenExchange.KeySet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := jwks.ParseKeySet(ctx, keySet)
+	claims := exchangedTokenClaims{}
+	if _, err := jwt.ParseWithClaims(exchanged.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		return keys[token.Header["kid"].(string)], nil
+	}, jwt.WithIssuer(testExchangeIssuer), jwt.WithAudience("invoice-service")); err != nil {
+		t.Fatalf("expected the exchanged token to verify with the published key set, got %v", err)
+	}
+	if claims.Act.Sub != "billing-service" || claims.Ext.ID == nil || len(claims.Scope) != 1 || claims.Scope[0] != "invoices:read" {
+		t.Fatalf("expected the exchanged token for the user on behalf of the actor, got %+v", claims)
+	}
+}
+
+func TestExchangeForAudienceCapsTheTTL(t *testing.T) {
+	ctx := context.Background()
+	request := model.AudienceExchangeRequest{
+		SubjectToken: "subject",
+		ActorToken:   "actor",
+		Audience:     "invoice-service",
+		Scope:        []string{"invoices:read"},
+	}
+
+	tests := []struct {
+		name             string
+		subjectExpiresIn time.Duration
+		expiresIn        time.Duration
+		expected         int64
+	}{
+		{"max ttl", time.Hour, 0, 300},
+		{"requested ttl above max ttl", time.Hour, time.Hour, 300},
+		{"requested ttl", time.Hour, time.Minute, 60},
+		{"subject token expiry", 2 * time.Minute, 0, 119},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tokenExchange := newTestTokenExchange(t, testExchangeTokens(test.subjectExpiresIn))
+			request.ExpiresIn = test.expiresIn
+			exchanged, err := tokenExchange.ExchangeForAudience(ctx, request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the subject token expiry is in whole seconds, the exchanged token may lose the second in progress.
+			if exchanged.ExpiresIn != test.expected && exchanged.ExpiresIn != test.expected+1 {
+				t.Fatalf("expected the token to expire in %d seconds, got %d", test.expected, exchanged.ExpiresIn)
+			}
+		})
+	}
+
+	tokenExchange := newTestTokenExchange(t, testExchangeTokens(-time.Minute))
+	request.ExpiresIn = 0
+	if _, err := tokenExchange.ExchangeForAudience(ctx, request); !errors.Is(err, ErrInvalidSubjectToken) {
+		t.Fatalf("expected an expired subject token to be rejected, got %v", err)
+	}
+}
+
+func TestTokenExchangeIsOptional(t *testing.T) {
+	tokenExchange, err := NewTokenExchangeService(http.DefaultClient, &config.App{})
+	if err != nil {
+		t.Fatalf("expected no signing key to be required without a token exchange config, got %v", err)
+	}
+	if _, err := tokenExchange.ExchangeForAudience(context.Background(), model.AudienceExchangeRequest{}); !errors.Is(err, ErrTokenExchangeDisabled) {
+		t.Fatalf("expected the token exchange to be disabled, got %v", err)
+	}
+	keySet, err := tokenExchange.KeySet()
+	if err != nil || len(keySet.Keys) != 0 {
+		t.Fatalf("expected an empty key set, got %+v %v", keySet, err)
+	}
+}
@@ -0,0 +1,101 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"token-management-service/domain"
+	"token-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+)
+
+// CreateClient creates an oauth2 client, the response carries its generated secret.
+func (h *GRPCHandler) CreateClient(ctx context.Context, request *pb.CreateClientRequest) (*pb.ClientResponse, error) {
+	client, err := h.clientManager.CreateClient(ctx, toClientRegistration(request.GetMetadata()))
+	if err != nil {
+		return nil, clientStatus(err)
+	}
+
+	return toClientResponse(client), nil
+}
+
+// UpdateClient replaces the metadata of an oauth2 client.
+func (h *GRPCHandler) UpdateClient(ctx context.Context, request *pb.UpdateClientRequest) (*pb.ClientResponse, error) {
+	client, err := h.clientManager.UpdateClient(ctx, request.ClientID, toClientRegistration(request.GetMetadata()))
+	if err != nil {
+		return nil, clientStatus(err)
+	}
+
+	return toClientResponse(client), nil
+}
+
+// RotateClientSecret replaces the secret of an oauth2 client, the response carries the new secret.
+func (h *GRPCHandler) RotateClientSecret(ctx context.Context, request *pb.RotateClientSecretRequest) (*pb.ClientResponse, error) {
+	client, err := h.clientManager.RotateClientSecret(ctx, request.ClientID)
+	if err != nil {
+		return nil, clientStatus(err)
+	}
+
+	return toClientResponse(client), nil
+}
+
+// DeleteClient deletes an oauth2 client.
+func (h *GRPCHandler) DeleteClient(ctx context.Context, request *pb.DeleteClientRequest) (*pb.EmptyGrpcMessage, error) {
+	if err := h.clientManager.DeleteClient(ctx, request.ClientID); err != nil {
+		return nil, clientStatus(err)
+	}
+
+	return &pb.EmptyGrpcMessage{}, nil
+}
+
+// clientStatus converts client management errors into gRPC status errors.
+func clientStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrClientNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidClientMetadata):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func toClientRegistration(metadata *pb.ClientMetadata) model.ClientRegistration {
+	return model.ClientRegistration{
+		ClientName:                metadata.GetClientName(),
+		RedirectURIs:              metadata.GetRedirectURIs(),
+		GrantTypes:                metadata.GetGrantTypes(),
+		ResponseTypes:             metadata.GetResponseTypes(),
+		Scope:                     strings.Join(metadata.GetScope(), " "),
+		Audience:                  metadata.GetAudience(),
+		TokenEndpointAuthMethod:   metadata.GetTokenEndpointAuthMethod(),
+		LogoURI:                   metadata.GetLogoURI(),
+		Trusted:                   metadata.GetTrusted(),
+		LoginRememberForSeconds:   int(metadata.GetLoginRememberForSeconds()),
+		ConsentRememberForSeconds: int(metadata.GetConsentRememberForSeconds()),
+	}
+}
+
+func toClientResponse(client *model.ClientRegistration) *pb.ClientResponse {
+	return &pb.ClientResponse{
+		ClientID:     client.ClientID,
+		ClientSecret: client.ClientSecret,
+		Metadata: &pb.ClientMetadata{
+			ClientName:                client.ClientName,
+			RedirectURIs:              client.RedirectURIs,
+			GrantTypes:                client.GrantTypes,
+			ResponseTypes:             client.ResponseTypes,
+			Scope:                     strings.Fields(client.Scope),
+			Audience:                  client.Audience,
+			TokenEndpointAuthMethod:   client.TokenEndpointAuthMethod,
+			LogoURI:                   client.LogoURI,
+			Trusted:                   client.Trusted,
+			LoginRememberForSeconds:   int32(client.LoginRememberForSeconds),
+			ConsentRememberForSeconds: int32(client.ConsentRememberForSeconds),
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"token-management-service/domain"
+	"token-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// IssueInitialAccessToken issues a single use token a partner registers their client with.
+func (h *GRPCHandler) IssueInitialAccessToken(ctx context.Context, request *pb.IssueInitialAccessTokenRequest) (*pb.InitialAccessTokenResponse, error) {
+	token, expiresAt, err := h.clientRegistrar.IssueInitialAccessToken(ctx, time.Duration(request.ExpiresInSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InitialAccessTokenResponse{Token: token, ExpiresAt: expiresAt.Unix()}, nil
+}
+
+// RegisterClient registers an oauth2 client with an initial access token (RFC 7591).
+func (h *GRPCHandler) RegisterClient(ctx context.Context, request *pb.RegisterClientRequest) (*pb.RegisteredClientResponse, error) {
+	client, err := h.clientRegistrar.RegisterClient(ctx, request.InitialAccessToken, toClientRegistration(request.GetMetadata()))
+	if err != nil {
+		return nil, registrationStatus(err)
+	}
+
+	return toRegisteredClientResponse(client), nil
+}
+
+// GetRegisteredClient returns a registered oauth2 client to the holder of its registration access token (RFC 7592).
+func (h *GRPCHandler) GetRegisteredClient(ctx context.Context, request *pb.RegisteredClientRequest) (*pb.RegisteredClientResponse, error) {
+	client, err := h.clientRegistrar.GetRegisteredClient(ctx, request.ClientID, request.RegistrationAccessToken)
+	if err != nil {
+		return nil, registrationStatus(err)
+	}
+
+	return toRegisteredClientResponse(client), nil
+}
+
+// UpdateRegisteredClient replaces the metadata of a registered oauth2 client (RFC 7592).
+func (h *GRPCHandler) UpdateRegisteredClient(ctx context.Context, request *pb.UpdateRegisteredClientRequest) (*pb.RegisteredClientResponse, error) {
+	client, err := h.clientRegistrar.UpdateRegisteredClient(ctx, request.ClientID, request.RegistrationAccessToken, toClientRegistration(request.GetMetadata()))
+	if err != nil {
+		return nil, registrationStatus(err)
+	}
+
+	return toRegisteredClientResponse(client), nil
+}
+
+// DeleteRegisteredClient deletes a registered oauth2 client (RFC 7592).
+func (h *GRPCHandler) DeleteRegisteredClient(ctx context.Context, request *pb.RegisteredClientRequest) (*pb.EmptyGrpcMessage, error) {
+	if err := h.clientRegistrar.DeleteRegisteredClient(ctx, request.ClientID, request.RegistrationAccessToken); err != nil {
+		return nil, registrationStatus(err)
+	}
+
+	return &pb.EmptyGrpcMessage{}, nil
+}
+
+// registrationStatus converts client registration errors into gRPC status errors, rejected metadata carries the
+// reason so callers can answer with the matching RFC 7591 error code.
+func registrationStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidRedirectURI):
+		return withReason(codes.InvalidArgument, err, constants.InvalidRedirectURI)
+	case errors.Is(err, domain.ErrInvalidClientMetadata):
+		return withReason(codes.InvalidArgument, err, constants.InvalidClientMetadata)
+	case errors.Is(err, domain.ErrInvalidInitialAccessToken), errors.Is(err, domain.ErrInvalidRegistrationAccessToken),
+		errors.Is(err, domain.ErrClientNotFound):
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return err
+}
+
+func withReason(code codes.Code, err error, reason string) error {
+	st, detailsErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: constants.ErrorDomain,
+	})
+	if detailsErr != nil {
+		return status.Error(code, err.Error())
+	}
+	return st.Err()
+}
+
+func toRegisteredClientResponse(client *model.RegisteredClient) *pb.RegisteredClientResponse {
+	response := toClientResponse(&client.ClientRegistration)
+	registered := &pb.RegisteredClientResponse{
+		ClientID:                response.ClientID,
+		ClientSecret:            response.ClientSecret,
+		RegistrationAccessToken: client.RegistrationAccessToken,
+		Metadata:                response.Metadata,
+	}
+	if !client.ClientIDIssuedAt.IsZero() {
+		registered.ClientIDIssuedAt = client.ClientIDIssuedAt.Unix()
+	}
+
+	return registered
+}
@@ -2,10 +2,15 @@ package grpcserver
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
 	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
 
 	"token-management-service/domain"
@@ -15,34 +20,116 @@ import (
 // GRPCHandler implements pb.TokenServiceServer gRPC interface.
 type GRPCHandler struct {
 	pb.UnimplementedTokenServiceServer
-	oauth2Service domain.Auth
+	oauth2Service   domain.Auth
+	clientManager   domain.ClientManager
+	clientRegistrar domain.ClientRegistrar
+	tokenExchanger  domain.TokenExchanger
 }
 
 // AcceptLogin accept login for user login challenge.
 func (h *GRPCHandler) AcceptLogin(ctx context.Context, loginRequest *pb.AcceptLoginRequest) (*pb.AcceptLoginResponse, error) {
 	userID := uuid.MustParse(loginRequest.UserProfile.ID)
 	acceptLoginResponse, err := h.oauth2Service.Accept(ctx, loginRequest.LoginChallenge, models.UserProfile{
-		ID:    &userID,
-		Name:  loginRequest.UserProfile.Name,
-		Email: loginRequest.UserProfile.Email,
+		ID:          &userID,
+		Name:        loginRequest.UserProfile.Name,
+		Email:       loginRequest.UserProfile.Email,
+		Roles:       loginRequest.UserProfile.Roles,
+		Permissions: loginRequest.UserProfile.Permissions,
+	}, model.LoginAuthentication{
+		Acr:      loginRequest.Acr,
+		Amr:      loginRequest.Amr,
+		Remember: loginRequest.Remember,
 	})
 	if err != nil {
-		return nil, err
+		return nil, loginStatus(err)
 	}
 
 	return &pb.AcceptLoginResponse{RedirectTo: acceptLoginResponse.RedirectTo}, nil
 }
 
-// AcceptConsent initiate and accept login consent for user login challenge.
+// GetLoginRequest returns the login request of a login challenge with the client and the login hint, skip is set
+// for remembered users.
+func (h *GRPCHandler) GetLoginRequest(ctx context.Context, request *pb.GetLoginRequestRequest) (*pb.GetLoginRequestResponse, error) {
+	loginRequest, err := h.oauth2Service.GetLoginRequest(ctx, request.LoginChallenge)
+	if err != nil {
+		return nil, loginStatus(err)
+	}
+	return &pb.GetLoginRequestResponse{
+		Skip:           loginRequest.Skip,
+		Subject:        loginRequest.Subject,
+		ClientID:       loginRequest.Client.ClientID,
+		ClientName:     loginRequest.Client.ClientName,
+		LogoURI:        loginRequest.Client.LogoURI,
+		RequestedScope: loginRequest.RequestedScope,
+		LoginHint:      loginRequest.OIDCContext.LoginHint,
+	}, nil
+}
+
+// loginStatus converts login challenge errors into gRPC status errors, so callers can tell expired challenges from
+// already used ones.
+func loginStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrLoginChallengeUsed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrLoginChallengeExpired), errors.Is(err, domain.ErrInvalidLoginChallenge):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+// AcceptConsent accepts the login consent with the scope and audience granted by the user.
 func (h *GRPCHandler) AcceptConsent(ctx context.Context, loginRequest *pb.AcceptConsentRequest) (*pb.AcceptConsentResponse, error) {
-	acceptConsentResponse, err := h.oauth2Service.AcceptConsent(ctx, loginRequest.ConsentChallenge)
+	acceptConsentResponse, err := h.oauth2Service.AcceptConsent(ctx, loginRequest.ConsentChallenge, model.ConsentGrant{
+		Scope:    loginRequest.GrantScope,
+		Audience: loginRequest.GrantAudience,
+		Remember: loginRequest.Remember,
+	})
 	if err != nil {
-		return nil, err
+		return nil, consentStatus(err)
 	}
 
 	return &pb.AcceptConsentResponse{RedirectTo: acceptConsentResponse.RedirectTo}, nil
 }
 
+// GetConsent returns the client and the requested scope and audience of a login consent.
+func (h *GRPCHandler) GetConsent(ctx context.Context, request *pb.GetConsentRequest) (*pb.GetConsentResponse, error) {
+	consent, err := h.oauth2Service.GetConsent(ctx, request.ConsentChallenge)
+	if err != nil {
+		return nil, consentStatus(err)
+	}
+
+	return &pb.GetConsentResponse{
+		ClientID:          consent.Client.ClientID,
+		ClientName:        consent.Client.ClientName,
+		LogoURI:           consent.Client.LogoURI,
+		RequestedScope:    consent.RequestedScope,
+		RequestedAudience: consent.RequestedAccessTokenAudience,
+		Skip:              consent.Skip,
+		Trusted:           consent.Trusted,
+	}, nil
+}
+
+// RejectConsent rejects a login consent denied by the user.
+func (h *GRPCHandler) RejectConsent(ctx context.Context, request *pb.RejectConsentRequest) (*pb.RejectConsentResponse, error) {
+	rejectConsentResponse, err := h.oauth2Service.RejectConsent(ctx, request.ConsentChallenge)
+	if err != nil {
+		return nil, consentStatus(err)
+	}
+
+	return &pb.RejectConsentResponse{RedirectTo: rejectConsentResponse.RedirectTo}, nil
+}
+
+// consentStatus converts consent errors into gRPC status errors, so callers can tell bad grants from bad challenges.
+func consentStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidConsentGrant):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidConsentChallenge):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
 // GenerateVerificationToken is grpc handler to generate token for verify/change credentials.
 func (h *GRPCHandler) GenerateVerificationToken(ctx context.Context, request *pb.GenerateVerificationTokenRequest) (*pb.ClientTokenResponse, error) {
 	clientToken, err := h.oauth2Service.AccessForClientToken(ctx, request.Email, request.ClientID)
@@ -66,6 +153,9 @@ func (h *GRPCHandler) ExchangeToken(ctx context.Context, tokenRequest *pb.TokenE
 		RedirectURI:  tokenRequest.RedirectURI,
 		ClientID:     tokenRequest.ClientID,
 		CodeVerifier: tokenRequest.CodeVerifier,
+		UserAgent:    tokenRequest.UserAgent,
+		IPAddress:    tokenRequest.IPAddress,
+		Nonce:        tokenRequest.Nonce,
 	})
 	if err != nil {
 		return nil, err
@@ -98,7 +188,7 @@ func (h *GRPCHandler) IntrospectVerificationToken(ctx context.Context, tokenRequ
 func (h *GRPCHandler) Introspect(ctx context.Context, tokenRequest *pb.IntrospectRequest) (*pb.IntrospectResponse, error) {
 	introspectResponse, err := h.oauth2Service.IntrospectToken(ctx, tokenRequest.AccessToken, tokenRequest.SessionID, model.AccessToken)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.IntrospectResponse{
@@ -120,9 +210,11 @@ func (h *GRPCHandler) Introspect(ctx context.Context, tokenRequest *pb.Introspec
 		NewAccessTokenExpiry:   introspectResponse.NewAccessTokenExpiry,
 		IDToken: &pb.IDToken{
 			UserProfile: &pb.UserProfile{
-				ID:    introspectResponse.UserInfo.ID.String(),
-				Name:  introspectResponse.UserInfo.Name,
-				Email: introspectResponse.UserInfo.Email,
+				ID:          introspectResponse.UserInfo.ID.String(),
+				Name:        introspectResponse.UserInfo.Name,
+				Email:       introspectResponse.UserInfo.Email,
+				Roles:       introspectResponse.UserInfo.Roles,
+				Permissions: introspectResponse.UserInfo.Permissions,
 			},
 			Subject:         introspectResponse.UserInfo.Subject,
 			AccessTokenHash: introspectResponse.UserInfo.AccessTokenHash,
@@ -134,7 +226,7 @@ func (h *GRPCHandler) Introspect(ctx context.Context, tokenRequest *pb.Introspec
 func (h *GRPCHandler) GenerateRefreshToken(ctx context.Context, refreshTokenRequest *pb.GenerateRefreshTokenRequest) (*pb.TokenExchangeResponse, error) {
 	tokenExchangeResponse, err := h.oauth2Service.FetchRefreshToken(ctx, refreshTokenRequest.GetAccessToken(), refreshTokenRequest.GetSessionID())
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.TokenExchangeResponse{
@@ -150,14 +242,112 @@ func (h *GRPCHandler) GenerateRefreshToken(ctx context.Context, refreshTokenRequ
 // RevokeAccessToken revokes the access token.
 func (h *GRPCHandler) RevokeAccessToken(ctx context.Context, revokeAccessTokenRequest *pb.RevokeAccessTokenRequest) (*pb.EmptyGrpcMessage, error) {
 	if err := h.oauth2Service.RevokeAccessToken(ctx, revokeAccessTokenRequest.AccessToken, revokeAccessTokenRequest.SessionID, revokeAccessTokenRequest.ClientID); err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.EmptyGrpcMessage{}, nil
+}
+
+// RevokeLoginSessions revokes the oauth2 server login and consent sessions of a subject.
+func (h *GRPCHandler) RevokeLoginSessions(ctx context.Context, request *pb.RevokeLoginSessionsRequest) (*pb.EmptyGrpcMessage, error) {
+	if err := h.oauth2Service.RevokeLoginSessions(ctx, request.GetSubject()); err != nil {
+		return nil, err
+	}
+	return &pb.EmptyGrpcMessage{}, nil
+}
+
+// ListSessions lists the active sessions of a user. The session of the request is only marked as current when its
+// access token matches too.
+func (h *GRPCHandler) ListSessions(ctx context.Context, request *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	sessions, err := h.oauth2Service.ListSessions(ctx, request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	currentSessionID := request.GetCurrentSessionID()
+	if !h.oauth2Service.IsSessionToken(ctx, currentSessionID, request.GetCurrentAccessToken()) {
+		currentSessionID = ""
+	}
+
+	response := &pb.ListSessionsResponse{Sessions: make([]*pb.SessionInfo, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, &pb.SessionInfo{
+			ID:         session.PublicID,
+			ClientID:   session.ClientID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt.Unix(),
+			LastUsedAt: session.LastUsedAt.Unix(),
+			Current:    len(currentSessionID) != 0 && session.SessionID == currentSessionID,
+		})
+	}
+
+	return response, nil
+}
+
+// RevokeSession revokes a single session of a user.
+func (h *GRPCHandler) RevokeSession(ctx context.Context, request *pb.RevokeSessionRequest) (*pb.EmptyGrpcMessage, error) {
+	if err := h.oauth2Service.RevokeSession(ctx, request.GetUserID(), request.GetID()); err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, err
 	}
 	return &pb.EmptyGrpcMessage{}, nil
 }
 
+// RevokeAllSessions revokes every session of a user.
+func (h *GRPCHandler) RevokeAllSessions(ctx context.Context, request *pb.RevokeAllSessionsRequest) (*pb.EmptyGrpcMessage, error) {
+	if err := h.oauth2Service.RevokeAllSessions(ctx, request.GetUserID()); err != nil {
+		return nil, err
+	}
+	return &pb.EmptyGrpcMessage{}, nil
+}
+
+// ListConsentSessions lists the consents a subject granted to oauth2 clients.
+func (h *GRPCHandler) ListConsentSessions(ctx context.Context, request *pb.ListConsentSessionsRequest) (*pb.ListConsentSessionsResponse, error) {
+	sessions, err := h.oauth2Service.ListConsentSessions(ctx, request.GetSubject())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListConsentSessionsResponse{Sessions: make([]*pb.ConsentSessionInfo, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, &pb.ConsentSessionInfo{
+			ClientID:        session.ConsentRequest.Client.ClientID,
+			ClientName:      session.ConsentRequest.Client.ClientName,
+			GrantedScopes:   session.GrantScope,
+			GrantedAudience: session.GrantAccessTokenAudience,
+			GrantedAt:       session.HandledAt.Unix(),
+			Remember:        session.Remember,
+		})
+	}
+
+	return response, nil
+}
+
+// toStatus converts domain errors which callers need to tell apart into gRPC status errors.
+func toStatus(err error) error {
+	if errors.Is(err, domain.ErrSessionCompromised) {
+		st, detailsErr := status.New(codes.Unauthenticated, err.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason: constants.SessionCompromised,
+			Domain: constants.ErrorDomain,
+		})
+		if detailsErr != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		return st.Err()
+	}
+	return err
+}
+
 // NewGRPCHandler creates an object of GRPCHandler.
-func NewGRPCHandler(oAuth2 domain.Auth) *GRPCHandler {
+func NewGRPCHandler(oAuth2 domain.Auth, clientManager domain.ClientManager, clientRegistrar domain.ClientRegistrar, tokenExchanger domain.TokenExchanger) *GRPCHandler {
 	return &GRPCHandler{
-		oauth2Service: oAuth2,
+		oauth2Service:   oAuth2,
+		clientManager:   clientManager,
+		clientRegistrar: clientRegistrar,
+		tokenExchanger:  tokenExchanger,
 	}
 }
@@ -0,0 +1,80 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"token-management-service/domain"
+	"token-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+)
+
+// ExchangeForAudience exchanges the access token of a user for a down-scoped, short-lived access token an internal
+// service calls another one with on behalf of the user (RFC 8693).
+func (h *GRPCHandler) ExchangeForAudience(ctx context.Context, request *pb.ExchangeForAudienceRequest) (*pb.ExchangeForAudienceResponse, error) {
+	exchanged, err := h.tokenExchanger.ExchangeForAudience(ctx, model.AudienceExchangeRequest{
+		SubjectToken: request.SubjectToken,
+		ActorToken:   request.ActorToken,
+		Audience:     request.Audience,
+		Scope:        request.Scope,
+		ExpiresIn:    time.Duration(request.ExpiresInSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, tokenExchangeStatus(err)
+	}
+
+	return &pb.ExchangeForAudienceResponse{
+		AccessToken:     exchanged.AccessToken,
+		IssuedTokenType: exchanged.IssuedTokenType,
+		TokenType:       exchanged.TokenType,
+		ExpiresIn:       exchanged.ExpiresIn,
+		Scope:           exchanged.Scope,
+	}, nil
+}
+
+// GetTokenExchangeKeySet returns the public key set exchanged access tokens are verified with, the user service
+// publishes it for resource servers.
+func (h *GRPCHandler) GetTokenExchangeKeySet(context.Context, *pb.EmptyGrpcMessage) (*pb.TokenExchangeKeySetResponse, error) {
+	keySet, err := h.tokenExchanger.KeySet()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*pb.JSONWebKey, 0, len(keySet.Keys))
+	for _, key := range keySet.Keys {
+		keys = append(keys, &pb.JSONWebKey{
+			Kid: key.KeyID,
+			Kty: key.KeyType,
+			Use: key.Use,
+			Alg: key.Algorithm,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Curve,
+			X:   key.X,
+			Y:   key.Y,
+		})
+	}
+
+	return &pb.TokenExchangeKeySetResponse{Keys: keys}, nil
+}
+
+// tokenExchangeStatus converts token exchange errors into gRPC status errors, so callers can tell an invalid token
+// apart from an audience or scope they are not allowed to ask for.
+func tokenExchangeStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidSubjectToken), errors.Is(err, domain.ErrInvalidActorToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrAudienceNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrInvalidExchangeScope):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTokenExchangeDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return err
+}
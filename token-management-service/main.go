@@ -17,6 +17,7 @@ import (
 	"token-management-service/domain"
 	"token-management-service/grpcserver"
 
+	"github.com/adjust/rmq/v5"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -40,6 +41,7 @@ var (
 	// program controller.
 	done    = make(chan struct{})
 	grpcErr = make(chan error)
+	rmqErr  = make(chan error, 10)
 
 	// config.
 	serviceConfig *config.AppConfig
@@ -130,6 +132,10 @@ func getSecrets() error {
 		oauthClient.Secret = data[serviceConfig.CISAuth.SecretKeys[key]]
 		serviceConfig.CISAuth.Clients[clientID] = oauthClient // Update the map with the modified secureClient
 	}
+	if tokenExchange := serviceConfig.CISAuth.TokenExchange; tokenExchange != nil {
+		signingKey := strings.Split(tokenExchange.SigningKey, ":")[1]
+		tokenExchange.SigningKey = data[serviceConfig.CISAuth.SecretKeys[signingKey]]
+	}
 	redisPassword = data["REDIS_DB_PASSWORD"]
 	redisHost = data["REDIS_DB_HOST"]
 	redisPort = data["REDIS_DB_PORT"]
@@ -178,10 +184,35 @@ func main() {
 		return
 	}
 
-	auth2 := domain.NewOAuth2(httpClient, redisClient, &serviceConfig.CISAuth)
+	connection, err := rmq.OpenConnectionWithRedisClient(serviceConfig.Name, redisClient, rmqErr)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to open connection for rmq", slog.Any(constants.Error, err))
+		return
+	}
+
+	emailQueue, err := connection.OpenQueue("email")
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to open email queue", slog.Any(constants.Error, err))
+		return
+	}
+
+	clientRegistry := domain.NewClientRegistry(redisClient)
+	if err := clientRegistry.Seed(ctx, serviceConfig.CISAuth.Clients); err != nil {
+		slog.ErrorContext(ctx, "unable to seed oauth2 client registry", slog.Any(constants.Error, err))
+		return
+	}
+
+	auth2 := domain.NewOAuth2(httpClient, redisClient, &serviceConfig.CISAuth, emailQueue, clientRegistry)
+	clientService := domain.NewClientService(httpClient, &serviceConfig.CISAuth, clientRegistry)
+	clientRegistrationService := domain.NewClientRegistrationService(redisClient, clientService, serviceConfig.CISAuth.ClientRegistration)
+	tokenExchangeService, err := domain.NewTokenExchangeService(httpClient, &serviceConfig.CISAuth)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to load token exchange signing key", slog.Any(constants.Error, err))
+		return
+	}
 
 	// grpc server
-	grpcHandler := grpcserver.NewGRPCHandler(auth2)
+	grpcHandler := grpcserver.NewGRPCHandler(auth2, clientService, clientRegistrationService, tokenExchangeService)
 
 	grpcServer := grpcserver.NewGRPCServer(strings.Join([]string{"", strconv.Itoa(serviceConfig.CISAuth.GRPCPort)}, ":"), grpcHandler)
 
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+const (
+	// RedisClientKeyPrefix is the key prefix for an oauth2 client of the client registry in cache.
+	RedisClientKeyPrefix = "oauth2Client"
+	// RedisSeededClientsKey is the key of the set of oauth2 clients of the client registry seeded from the service config.
+	RedisSeededClientsKey = "oauth2ClientSeeded"
+	// RedisInitialAccessTokenKeyPrefix is the key prefix for the hash of an unused initial access token in cache.
+	RedisInitialAccessTokenKeyPrefix = "initialAccessToken"
+	// RedisRegistrationAccessTokenKeyPrefix is the key prefix for the registration access token hash of a client in cache.
+	RedisRegistrationAccessTokenKeyPrefix = "registrationAccessToken"
+)
+
+// ClientRegistration model for an oauth2 client of the oauth2 server admin api.
+// Trusted and the remember durations are only kept in the client registry of the token service.
+type ClientRegistration struct {
+	ClientID                  string   `json:"client_id,omitempty"`
+	ClientSecret              string   `json:"client_secret,omitempty"`
+	ClientName                string   `json:"client_name"`
+	RedirectURIs              []string `json:"redirect_uris"`
+	GrantTypes                []string `json:"grant_types"`
+	ResponseTypes             []string `json:"response_types"`
+	Scope                     string   `json:"scope"`
+	Audience                  []string `json:"audience"`
+	TokenEndpointAuthMethod   string   `json:"token_endpoint_auth_method"`
+	LogoURI                   string   `json:"logo_uri,omitempty"`
+	Trusted                   bool     `json:"-"`
+	LoginRememberForSeconds   int      `json:"-"`
+	ConsentRememberForSeconds int      `json:"-"`
+}
+
+// JSONPatchOperation model for a json patch operation of the oauth2 server admin api.
+type JSONPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// RegisteredClient model for an oauth2 client registered through dynamic client registration, with the registration
+// access token it is read, updated and deleted with.
+type RegisteredClient struct {
+	ClientRegistration
+	RegistrationAccessToken string
+	ClientIDIssuedAt        time.Time
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// EventType is an enum type for email notification events.
+type EventType string
+
+const (
+	// SecurityAlertEvent notifies the user about suspicious activity on their account.
+	SecurityAlertEvent EventType = "SecurityAlertEvent"
+)
+
+const (
+	// RefreshTokenReuseReason is the security alert reason when a rotated-out refresh token is reused.
+	RefreshTokenReuseReason = "refresh_token_reuse"
+)
+
+// Event is a model for the events published to the email queue.
+type Event struct {
+	Email        string
+	Type         EventType
+	EventPayload []byte
+}
+
+// SecurityAlertPayload is the payload of SecurityAlertEvent.
+type SecurityAlertPayload struct {
+	Reason     string    `json:"reason"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
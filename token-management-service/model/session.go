@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+const (
+	// RedisSessionMetadataKeyPrefix is the key prefix for the metadata of a session in cache.
+	RedisSessionMetadataKeyPrefix = "sessionMetadata"
+	// RedisUserSessionsKeyPrefix is the key prefix for the session index of a user in cache.
+	RedisUserSessionsKeyPrefix = "userSessions"
+	// RedisRefreshTokenHistoryKeyPrefix is the key prefix for the hashes of the superseded refresh tokens of a session in cache.
+	RedisRefreshTokenHistoryKeyPrefix = "refreshTokenHistory"
+	// RedisRememberedLoginKeyPrefix is the key prefix for how the user of a remembered oauth2 login session authenticated.
+	RedisRememberedLoginKeyPrefix = "rememberedLogin"
+)
+
+// SessionMetadata is a model for the device and usage details of a user session.
+// PublicID is a non-secret identifier of the session which is safe to hand out to the user,
+// unlike the session id which is a bearer credential.
+type SessionMetadata struct {
+	SessionID  string    `json:"session_id"`
+	PublicID   string    `json:"public_id"`
+	UserID     string    `json:"user_id"`
+	ClientID   string    `json:"client_id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
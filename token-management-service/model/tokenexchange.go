@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+const (
+	// IssuedTokenTypeAccessToken is the RFC 8693 token type identifier of exchanged access tokens.
+	IssuedTokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+	// TokenTypeBearer is the token type of exchanged access tokens.
+	TokenTypeBearer = "Bearer"
+)
+
+// AudienceExchangeRequest is a request of an internal service for a token to call Audience on behalf of the user of
+// SubjectToken. ActorToken is the client credentials access token of the acting service.
+type AudienceExchangeRequest struct {
+	SubjectToken string
+	ActorToken   string
+	Audience     string
+	Scope        []string
+	ExpiresIn    time.Duration
+}
+
+// ExchangedToken model for a down-scoped access token issued by token exchange.
+type ExchangedToken struct {
+	AccessToken     string
+	IssuedTokenType string
+	TokenType       string
+	ExpiresIn       int64
+	Scope           []string
+}
+
+// Actor model for the act claim of an exchanged access token, Sub is the client id of the acting service.
+type Actor struct {
+	Sub string `json:"sub"`
+}
@@ -34,14 +34,69 @@ type LoginAcceptRequest struct {
 	Remember    bool               `json:"remember"`
 	RememberFor int                `json:"remember_for"`
 	Acr         string             `json:"acr"`
+	Amr         []string           `json:"amr,omitempty"`
 	Userprofile models.UserProfile `json:"Context"`
 }
 
+// LoginAuthentication describes how the user authenticated for a login and whether they asked to be remembered.
+type LoginAuthentication struct {
+	Acr      string
+	Amr      []string
+	Remember bool
+}
+
+// RememberedLogin is how the user of a remembered oauth2 login session authenticated, skipped logins of the session
+// are accepted with it.
+type RememberedLogin struct {
+	Subject string   `json:"subject"`
+	Acr     string   `json:"acr"`
+	Amr     []string `json:"amr"`
+}
+
+// LoginRequest model for oauth2 login request response.
+// Skip is set when the user already has a remembered login session with the oauth2 server, the login then has to be
+// accepted for Subject without asking for credentials. SessionID identifies the login session of the user with the
+// oauth2 server.
+type LoginRequest struct {
+	Challenge      string           `json:"challenge"`
+	Skip           bool             `json:"skip"`
+	Subject        string           `json:"subject"`
+	Client         OAuth2Client     `json:"client"`
+	RequestedScope []string         `json:"requested_scope"`
+	SessionID      string           `json:"session_id"`
+	OIDCContext    LoginOIDCContext `json:"oidc_context"`
+}
+
+// LoginOIDCContext model for the openid connect parameters of a login request.
+type LoginOIDCContext struct {
+	LoginHint string `json:"login_hint"`
+}
+
 // ConsentAcceptResponse model for oauth2 consent accept response.
+// Skip is set when the user already granted a remembered consent to the client, Trusted when the client is configured
+// as a first-party client.
 type ConsentAcceptResponse struct {
 	Userprofile                  models.UserProfile `json:"Context"`
 	RequestedAccessTokenAudience []string           `json:"requested_access_token_audience"`
 	RequestedScope               []string           `json:"requested_scope"`
+	Skip                         bool               `json:"skip"`
+	Subject                      string             `json:"subject"`
+	Client                       OAuth2Client       `json:"client"`
+	Trusted                      bool               `json:"-"`
+}
+
+// ConsentGrant is the scope and audience the user grants to a client on the consent screen.
+type ConsentGrant struct {
+	Scope    []string
+	Audience []string
+	Remember bool
+}
+
+// RejectRequest model for oauth2 login and consent reject request.
+type RejectRequest struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	StatusCode       int    `json:"status_code"`
 }
 
 // ConsentAcceptInitiateRequest model for oauth2 initiate consent response.
@@ -53,9 +108,32 @@ type ConsentAcceptInitiateRequest struct {
 	Session                  Session  `json:"session"`
 }
 
+// ConsentSession model for a consent previously granted to an oauth2 client, as listed by the oauth2 server.
+type ConsentSession struct {
+	ConsentRequest           ConsentSessionRequest `json:"consent_request"`
+	GrantScope               []string              `json:"grant_scope"`
+	GrantAccessTokenAudience []string              `json:"grant_access_token_audience"`
+	Remember                 bool                  `json:"remember"`
+	HandledAt                time.Time             `json:"handled_at"`
+}
+
+// ConsentSessionRequest model for the consent request a consent session was granted for.
+type ConsentSessionRequest struct {
+	Client OAuth2Client `json:"client"`
+}
+
+// OAuth2Client model for the public details of an oauth2 client.
+type OAuth2Client struct {
+	ClientID   string `json:"client_id"`
+	ClientName string `json:"client_name"`
+	LogoURI    string `json:"logo_uri"`
+}
+
 // Session model for session id token/user claim.
+// The access token claim ends up in the ext claim of JWT access tokens so they can be validated locally.
 type Session struct {
-	Userprofile models.UserProfile `json:"id_token"`
+	Userprofile        models.UserProfile `json:"id_token"`
+	AccessTokenProfile models.UserProfile `json:"access_token"`
 }
 
 // TokenExchangeResponse model for response of code exchange for token with oauth2 server.
@@ -84,6 +162,9 @@ type TokenExchangeRequest struct {
 	RedirectURI  string `json:"redirect_uri" binding:"required"`
 	ClientID     string `json:"client_id" binding:"required"`
 	CodeVerifier string `json:"code_verifier" binding:"required"`
+	UserAgent    string `json:"-"`
+	IPAddress    string `json:"-"`
+	Nonce        string `json:"-"`
 }
 
 // IntrospectResponse is a model for oauth2 token introspection response.
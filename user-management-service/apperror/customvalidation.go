@@ -19,7 +19,7 @@ var (
 	mustBeAtleastFiveCharLong   = "must be atleast 5 characters long"
 	mustBeAtmostHundredCharLong = "must be atmost 100 characters long"
 	shouldBeOfTypeAlpha         = "should be of type alpha"
-	shouldBeOfTypePassword      = "should contain minimum 8, maximum 128 characters, 1 uppercase, 1 lowercase, 1 digit & 1 special character"
+	shouldBeOfTypePassword      = "should contain minimum 8, maximum 72 characters, 1 uppercase, 1 lowercase, 1 digit & 1 special character"
 )
 
 var (
@@ -37,6 +37,7 @@ var (
 		"name.alphaSpace":               errors.New(shouldBeOfTypeAlpha),
 		"newPassword.required":          errors.New(isRequired),
 		"password.required":             errors.New(isRequired),
+		"password.required_without":     errors.New("is required without code"),
 		"password.password":             errors.New(shouldBeOfTypePassword),
 		"oldPassword.required":          errors.New(isRequired),
 		"oldPassword.password":          errors.New(shouldBeOfTypePassword),
@@ -49,9 +50,38 @@ var (
 		"ConsentChallenge.required":     errors.New(isRequired),
 		"redirectURI.required":          errors.New(isRequired),
 		"code.required":                 errors.New(isRequired),
+		"code.required_without":         errors.New("is required without password"),
 		"clientID.required":             errors.New(isRequired),
 		"codeVerifier.required":         errors.New(isRequired),
 		"consent_challenge.required":    errors.New(isRequired),
+		"consentChallenge.required":     errors.New(isRequired),
+		"token.required":                errors.New(isRequired),
+		"id.required":                   errors.New(isRequired),
+		"id.base64rawurl":               errors.New(formatIsIncorrect),
+		"userID.required":               errors.New(isRequired),
+		"userID.uuid":                   errors.New(formatIsIncorrect),
+		"search.max":                    errors.New(mustBeAtmostHundredCharLong),
+		"page.min":                      errors.New("must be atleast 1"),
+		"pageSize.min":                  errors.New("must be atleast 1"),
+		"pageSize.max":                  errors.New("must be atmost 100"),
+		"code.min":                      errors.New("must be atleast 6 characters long"),
+		"code.max":                      errors.New("must be atmost 20 characters long"),
+		"mfaToken.required":             errors.New(isRequired),
+		"role.required":                 errors.New(isRequired),
+		"role.max":                      errors.New("must be atmost 50 characters long"),
+		"provider.required":             errors.New(isRequired),
+		"state.required":                errors.New(isRequired),
+
+		// related to oauth2 client management
+		"clientName.required":              errors.New(isRequired),
+		"clientName.max":                   errors.New(mustBeAtmostHundredCharLong),
+		"grantTypes.required":              errors.New(isRequired),
+		"tokenEndpointAuthMethod.required": errors.New(isRequired),
+		"tokenEndpointAuthMethod.oneof":    errors.New("should be one of client_secret_basic, client_secret_post or none"),
+		"logoURI.url":                      errors.New("should be a url"),
+		"loginRememberForSeconds.gte":      errors.New("must be atleast 0"),
+		"consentRememberForSeconds.gte":    errors.New("must be atleast 0"),
+		"expiresInSeconds.gte":             errors.New("must be atleast 0"),
 
 		// related to service config
 		"appinsightsInstrumentationKey.required": errors.New(isRequired),
@@ -123,6 +153,15 @@ var loginChallenge validator.Func = func(fl validator.FieldLevel) bool {
 	return false
 }
 
+// FieldErrors converts messages about a single field into the custom error type.
+func FieldErrors(field string, messages []string) []map[string]string {
+	errs := make([]map[string]string, 0, len(messages))
+	for _, message := range messages {
+		errs = append(errs, map[string]string{field: message})
+	}
+	return errs
+}
+
 // CustomValidationError converts validation and json marshal error into custom error type.
 func CustomValidationError(err error) []map[string]string {
 	errs := make([]map[string]string, 0)
@@ -27,6 +27,142 @@ type ServiceConfig struct {
 	SecretKey                  string
 	RefreshTokenExpiry         int
 	TokenManagementServiceHost string
+	GRPCPort                   int
+	ForgotPasswordClientID     string
+	LocalTokenValidation       *LocalTokenValidation
+	PasswordPolicy             PasswordPolicy
+	PasswordHashing            PasswordHashing
+	LoginProtection            LoginProtection
+	MFA                        MFA
+	EmailOTP                   EmailOTP
+	WebAuthn                   WebAuthn
+	Federation                 Federation
+	AccountDeletion            AccountDeletion
+	ClientRegistration         ClientRegistration
+	// TrustedProxies are the addresses or CIDRs of the load balancers in front of the service, only their
+	// X-Forwarded-For headers are used for the client ip of login throttling and security alerts.
+	TrustedProxies []string
+}
+
+// ClientRegistration configures dynamic client registration for partner applications.
+type ClientRegistration struct {
+	// RegistrationURL is the public url of the registration endpoint, registered clients are managed below it.
+	RegistrationURL string
+}
+
+// AccountDeletion configures how long deleted accounts can be restored and how they are purged afterwards.
+type AccountDeletion struct {
+	// GracePeriodHours is how long a deleted account is kept before it is purged.
+	GracePeriodHours int
+	// PurgeIntervalMinutes is how often deleted accounts past their grace period are purged, zero disables the purge.
+	PurgeIntervalMinutes int
+	// Anonymize keeps purged accounts as anonymized rows instead of deleting them.
+	Anonymize bool
+}
+
+// Federation configures login with upstream OpenID Connect identity providers.
+type Federation struct {
+	// RedirectURL is the callback page of the login app, it posts the code and state back to the user service.
+	RedirectURL string
+	// StateExpirySeconds is how long a login can stay at the upstream provider.
+	StateExpirySeconds int
+	Providers          []IdentityProvider
+}
+
+// IdentityProvider is an upstream OpenID Connect provider users can sign in with.
+type IdentityProvider struct {
+	// Name identifies the provider in login requests and linked identities, it must not change once users signed in.
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// EmailOTP configures passwordless login with a one-time code sent by email.
+type EmailOTP struct {
+	// CodeExpirySeconds is how long a sent code can be used.
+	CodeExpirySeconds int
+	// MaxAttempts is how many codes can be tried before a new one has to be requested.
+	MaxAttempts int
+	// ResendIntervalSeconds is the minimum time between two codes sent to the same email.
+	ResendIntervalSeconds int
+}
+
+// WebAuthn configures the relying party of passkey registration and login.
+type WebAuthn struct {
+	RPID                   string
+	RPDisplayName          string
+	RPOrigins              []string
+	ChallengeExpirySeconds int
+}
+
+// PasswordPolicy configures the rules new passwords have to satisfy at registration and reset.
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSpecial   bool
+	// HistorySize is how many of the previous passwords of a user can't be reused.
+	HistorySize int
+	// BreachedPasswordsFile is an offline list of breached passwords, one per line.
+	BreachedPasswordsFile string
+}
+
+// PasswordHashing configures how passwords are hashed, stored hashes with another algorithm or weaker
+// parameters are re-hashed at the next successful login.
+type PasswordHashing struct {
+	// Algorithm is either bcrypt or argon2id.
+	Algorithm  string
+	BcryptCost int
+	// Argon2Memory is the memory cost of argon2id in KiB.
+	Argon2Memory      uint32
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+	Argon2SaltLength  uint32
+	Argon2KeyLength   uint32
+}
+
+// LoginProtection configures the failed password login tracking against brute-force attacks.
+type LoginProtection struct {
+	// MaxAccountFailures is how many failed logins of an account are allowed before it is temporarily locked.
+	MaxAccountFailures int
+	// MaxIPFailures is how many failed logins from an ip address are allowed before it is temporarily blocked.
+	MaxIPFailures int
+	// FailureWindowSeconds is how long failed logins are counted after the last failure.
+	FailureWindowSeconds int
+	// LockoutSeconds is the first lockout, it doubles with every further failure up to MaxLockoutSeconds.
+	LockoutSeconds    int
+	MaxLockoutSeconds int
+}
+
+// MFA configures time-based one-time password multi-factor authentication.
+type MFA struct {
+	// Issuer is shown next to the account in authenticator apps.
+	Issuer string
+	// SecretKeyID is the KMS key encrypting totp secrets at rest.
+	SecretKeyID string
+	// PendingLoginExpirySeconds is how long a password verified login waits for its second factor.
+	PendingLoginExpirySeconds int
+	// MaxAttempts is how many codes can be tried for a pending login.
+	MaxAttempts int
+}
+
+// LocalTokenValidation enables validating JWT access tokens against the oauth2 server JWKS
+// instead of introspecting every request through the token service. It is off unless configured,
+// since logout, session revocation and account locks only apply once the access token is introspected.
+// The access tokens exchanged by the token service are accepted when TokenExchangeIssuer is set, they are verified
+// with the key set served at TokenExchangeKeySetURL and must carry the Audience.
+type LocalTokenValidation struct {
+	KeySetURL              string
+	Issuer                 string
+	Audience               string
+	Scopes                 []string
+	RefreshWindowSeconds   int
+	TokenExchangeIssuer    string
+	TokenExchangeKeySetURL string
 }
 
 func Load() (*ServiceConfig, error) {
@@ -2,4 +2,29 @@ package constants
 
 const (
 	RegistrationEmailCount = "emailCount"
+	// EmailChangeKeyPrefix is the redis key prefix of email changes waiting for the verification of the new email.
+	EmailChangeKeyPrefix = "email:change"
+	// LoginFailuresKeyPrefix is the redis key prefix counting failed password logins of an account or ip address.
+	LoginFailuresKeyPrefix = "login:failures"
+	// LoginLockoutKeyPrefix is the redis key prefix of temporarily locked accounts and blocked ip addresses.
+	LoginLockoutKeyPrefix = "login:lockout"
+	// PendingMFALoginKeyPrefix is the redis key prefix of logins waiting for their second factor.
+	PendingMFALoginKeyPrefix = "mfa:login"
+	// UsedTOTPKeyPrefix is the redis key prefix of totp codes which were already used.
+	UsedTOTPKeyPrefix = "mfa:totp:used"
+	// LoginCodeKeyPrefix is the redis key prefix of one-time codes sent for passwordless logins.
+	LoginCodeKeyPrefix = "login:otp"
+	// LoginCodeSentKeyPrefix is the redis key prefix throttling how often codes are sent to an email.
+	LoginCodeSentKeyPrefix = "login:otp:sent"
+	// FederationStateKeyPrefix is the redis key prefix of logins redirected to an upstream identity provider.
+	FederationStateKeyPrefix = "federation:state"
+	// FederationLinkKeyPrefix is the redis key prefix of upstream identities waiting for a login of the existing user
+	// with the same email before they are linked.
+	FederationLinkKeyPrefix = "federation:link"
+	// AccountPurgeLockKey is the redis key held by the replica purging deleted users for the current purge interval.
+	AccountPurgeLockKey = "account:purge:lock"
+	// WebAuthnRegistrationKeyPrefix is the redis key prefix of pending passkey registrations.
+	WebAuthnRegistrationKeyPrefix = "webauthn:registration"
+	// WebAuthnLoginKeyPrefix is the redis key prefix of pending passkey logins.
+	WebAuthnLoginKeyPrefix = "webauthn:login"
 )
@@ -0,0 +1,62 @@
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "roles"
+(
+    "ID"           UUID         NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "name"         VARCHAR(50)  NOT NULL UNIQUE,
+    "description"  VARCHAR(255) NOT NULL DEFAULT '',
+    "createdAtUTC" TIMESTAMP(3) NOT NULL DEFAULT NOW()
+);
+
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "permissions"
+(
+    "ID"           UUID         NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "name"         VARCHAR(100) NOT NULL UNIQUE,
+    "description"  VARCHAR(255) NOT NULL DEFAULT '',
+    "createdAtUTC" TIMESTAMP(3) NOT NULL DEFAULT NOW()
+);
+
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "role_permissions"
+(
+    "roleID"       UUID NOT NULL REFERENCES "roles" ("ID") ON DELETE CASCADE,
+    "permissionID" UUID NOT NULL REFERENCES "permissions" ("ID") ON DELETE CASCADE,
+    PRIMARY KEY ("roleID", "permissionID")
+);
+
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "user_roles"
+(
+    "userID"       UUID         NOT NULL REFERENCES "users" ("ID") ON DELETE CASCADE,
+    "roleID"       UUID         NOT NULL REFERENCES "roles" ("ID") ON DELETE CASCADE,
+    "createdAtUTC" TIMESTAMP(3) NOT NULL DEFAULT NOW(),
+    PRIMARY KEY ("userID", "roleID")
+);
+
+-- SeedData
+INSERT INTO "roles" ("name", "description")
+VALUES ('user', 'Default role of every registered user'),
+       ('admin', 'Manages users and their roles')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('profile:read', 'Read own profile'),
+       ('profile:write', 'Update own profile'),
+       ('users:read', 'Read any user'),
+       ('users:write', 'Manage any user'),
+       ('roles:write', 'Assign roles to users')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permissions" ("roleID", "permissionID")
+SELECT r."ID", p."ID"
+FROM "roles" r
+         JOIN "permissions" p ON r."name" = 'admin' OR p."name" IN ('profile:read', 'profile:write')
+ON CONFLICT DO NOTHING;
+
+-- every existing user gets the default role.
+INSERT INTO "user_roles" ("userID", "roleID")
+SELECT u."ID", r."ID"
+FROM "users" u,
+     "roles" r
+WHERE r."name" = 'user'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS "users_name_idx";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "passwordResetRequired";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "lockedAtUTC";
+
+UPDATE "users" SET "deletedAtUTC" = NOW() WHERE "deletedAtUTC" IS NULL;
+ALTER TABLE "users" ALTER COLUMN "deletedAtUTC" SET DEFAULT NOW();
+ALTER TABLE "users" ALTER COLUMN "deletedAtUTC" SET NOT NULL;
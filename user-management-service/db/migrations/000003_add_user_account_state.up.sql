@@ -0,0 +1,9 @@
+-- deletedAtUTC marks soft-deleted users, active users have no value.
+ALTER TABLE "users" ALTER COLUMN "deletedAtUTC" DROP NOT NULL;
+ALTER TABLE "users" ALTER COLUMN "deletedAtUTC" DROP DEFAULT;
+UPDATE "users" SET "deletedAtUTC" = NULL;
+
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "lockedAtUTC" TIMESTAMP(3);
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "passwordResetRequired" BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS "users_name_idx" ON "users" (LOWER("name"));
@@ -0,0 +1,19 @@
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "user_totp"
+(
+    "userID"          UUID         NOT NULL PRIMARY KEY REFERENCES "users" ("ID") ON DELETE CASCADE,
+    "encryptedSecret" VARCHAR      NOT NULL,
+    "enabled"         BOOLEAN      NOT NULL DEFAULT FALSE,
+    "createdAtUTC"    TIMESTAMP(3) NOT NULL DEFAULT NOW(),
+    "confirmedAtUTC"  TIMESTAMP(3)
+);
+
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "user_recovery_codes"
+(
+    "userID"       UUID         NOT NULL REFERENCES "users" ("ID") ON DELETE CASCADE,
+    "codeHash"     VARCHAR(64)  NOT NULL,
+    "createdAtUTC" TIMESTAMP(3) NOT NULL DEFAULT NOW(),
+    "usedAtUTC"    TIMESTAMP(3),
+    PRIMARY KEY ("userID", "codeHash")
+);
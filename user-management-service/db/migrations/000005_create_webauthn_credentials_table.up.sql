@@ -0,0 +1,12 @@
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "webauthn_credentials"
+(
+    "ID"            BYTEA        NOT NULL PRIMARY KEY,
+    "userID"        UUID         NOT NULL REFERENCES "users" ("ID") ON DELETE CASCADE,
+    "name"          VARCHAR(100) NOT NULL,
+    "credential"    JSONB        NOT NULL,
+    "createdAtUTC"  TIMESTAMP(3) NOT NULL DEFAULT NOW(),
+    "lastUsedAtUTC" TIMESTAMP(3)
+);
+
+CREATE INDEX IF NOT EXISTS "webauthn_credentials_user_idx" ON "webauthn_credentials" ("userID");
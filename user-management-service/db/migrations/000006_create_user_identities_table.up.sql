@@ -0,0 +1,12 @@
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "user_identities"
+(
+    "provider"     VARCHAR(50)  NOT NULL,
+    "subject"      VARCHAR(255) NOT NULL,
+    "userID"       UUID         NOT NULL REFERENCES "users" ("ID") ON DELETE CASCADE,
+    "email"        VARCHAR(50),
+    "createdAtUTC" TIMESTAMP(3) NOT NULL DEFAULT NOW(),
+    PRIMARY KEY ("provider", "subject")
+);
+
+CREATE INDEX IF NOT EXISTS "user_identities_user_idx" ON "user_identities" ("userID");
@@ -0,0 +1,15 @@
+-- CreateTable
+CREATE TABLE IF NOT EXISTS "password_history"
+(
+    "userID"       UUID         NOT NULL REFERENCES "users" ("ID") ON DELETE CASCADE,
+    "passwordHash" VARCHAR      NOT NULL,
+    "createdAtUTC" TIMESTAMP(3) NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "password_history_user_idx" ON "password_history" ("userID", "createdAtUTC" DESC);
+
+-- the current password of existing users is the start of their history.
+INSERT INTO "password_history" ("userID", "passwordHash")
+SELECT u."ID", u."password"
+FROM "users" u
+WHERE u."password" <> '';
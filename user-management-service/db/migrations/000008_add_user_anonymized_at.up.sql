@@ -0,0 +1,4 @@
+-- anonymizedAtUTC marks deleted users whose personal data was removed after the deletion grace period.
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "anonymizedAtUTC" TIMESTAMP(3);
+
+CREATE INDEX IF NOT EXISTS "users_deleted_at_idx" ON "users" ("deletedAtUTC") WHERE "deletedAtUTC" IS NOT NULL;
@@ -0,0 +1,11 @@
+-- SeedData
+INSERT INTO "permissions" ("name", "description")
+VALUES ('clients:write', 'Manage oauth2 clients')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permissions" ("roleID", "permissionID")
+SELECT r."ID", p."ID"
+FROM "roles" r
+         JOIN "permissions" p ON p."name" = 'clients:write'
+WHERE r."name" = 'admin'
+ON CONFLICT DO NOTHING;
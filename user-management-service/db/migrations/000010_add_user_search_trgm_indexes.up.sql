@@ -0,0 +1,7 @@
+-- the admin user search matches ILIKE '%term%' on email and name, which only trigram indexes can serve.
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+DROP INDEX IF EXISTS "users_name_idx";
+
+CREATE INDEX IF NOT EXISTS "users_name_trgm_idx" ON "users" USING GIN ("name" gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS "users_email_trgm_idx" ON "users" USING GIN ("email" gin_trgm_ops);
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	umsConstants "user-management-service/constants"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// AccountPurge periodically purges deleted users once their grace period has passed,
+// until then an administrator can still restore them. Every replica runs the purge, a redis lock
+// held for the purge interval lets only one of them purge per interval.
+type AccountPurge struct {
+	service     Service
+	redisClient *redis.Client
+	config      config.AccountDeletion
+}
+
+// Run purges deleted users every purge interval until the context is done.
+func (p *AccountPurge) Run(ctx context.Context) {
+	if p.config.PurgeIntervalMinutes <= 0 {
+		slog.InfoContext(ctx, "purge of deleted users is disabled")
+		return
+	}
+	ticker := time.NewTicker(time.Duration(p.config.PurgeIntervalMinutes) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		p.Purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge purges the users deleted longer than the grace period ago, unless another replica purged them within the
+// purge interval.
+func (p *AccountPurge) Purge(ctx context.Context) {
+	// the lock is not released, it expires with the interval so that the next purge is up to any replica again.
+	interval := time.Duration(p.config.PurgeIntervalMinutes) * time.Minute
+	acquired, err := p.redisClient.SetNX(ctx, umsConstants.AccountPurgeLockKey, time.Now().UTC().Format(time.RFC3339), interval).Result()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to acquire purge lock", slog.Any(constants.Error, err))
+		return
+	}
+	if !acquired {
+		slog.InfoContext(ctx, "deleted users are purged by another replica")
+		return
+	}
+
+	purged, err := p.service.PurgeDeletedUsers(ctx, time.Duration(p.config.GracePeriodHours)*time.Hour, p.config.Anonymize)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to purge deleted users", slog.Any(constants.Error, err))
+		return
+	}
+	if purged > 0 {
+		slog.InfoContext(ctx, "purged deleted users", slog.Int64("count", purged), slog.Bool("anonymize", p.config.Anonymize))
+	}
+}
+
+// NewAccountPurge creates the purge of deleted users with the configured grace period.
+func NewAccountPurge(accountDeletion config.AccountDeletion, service Service, redisClient *redis.Client) *AccountPurge {
+	return &AccountPurge{
+		service:     service,
+		redisClient: redisClient,
+		config:      accountDeletion,
+	}
+}
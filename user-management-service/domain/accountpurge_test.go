@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+)
+
+// fakePurgeService counts the purges of deleted users, other methods of the service are not used by the purge.
+type fakePurgeService struct {
+	Service
+	purges int
+}
+
+func (f *fakePurgeService) PurgeDeletedUsers(_ context.Context, _ time.Duration, _ bool) (int64, error) {
+	f.purges++
+	return 1, nil
+}
+
+func TestAccountPurgeRunsOncePerIntervalAcrossReplicas(t *testing.T) {
+	ctx := context.Background()
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	accountDeletion := config.AccountDeletion{GracePeriodHours: 720, PurgeIntervalMinutes: 60}
+	service := &fakePurgeService{}
+	replicas := []*AccountPurge{
+		NewAccountPurge(accountDeletion, service, redisClient),
+		NewAccountPurge(accountDeletion, service, redisClient),
+	}
+
+	for _, replica := range replicas {
+		replica.Purge(ctx)
+	}
+	if service.purges != 1 {
+		t.Fatalf("expected only one replica to purge within the interval, got %d purges", service.purges)
+	}
+
+	redisServer.FastForward(time.Hour)
+	for _, replica := range replicas {
+		replica.Purge(ctx)
+	}
+	if service.purges != 2 {
+		t.Fatalf("expected the next interval to be purged once, got %d purges", service.purges)
+	}
+}
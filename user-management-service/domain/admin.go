@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"user-management-service/model"
+
+	"github.com/lib/pq"
+)
+
+// userDataTables are the tables holding data linked to a user, they are cleared when a user is anonymized.
+var userDataTables = []string{"user_roles", "user_totp", "user_recovery_codes", "webauthn_credentials", "user_identities", "password_history"}
+
+// ListUsers returns a page of users matching the email or name search along with the total number of matches.
+func (s *service) ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error) {
+	conditions := []string{`($1 = '' OR email ILIKE '%' || $1 || '%' OR name ILIKE '%' || $1 || '%')`}
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, `"deletedAtUTC" IS NULL`)
+	}
+	where := strings.Join(conditions, " AND ")
+	search := escapeLike(filter.Search)
+
+	var total int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users WHERE `+where, search).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `SELECT `+userColumns+` FROM users WHERE `+where+` ORDER BY "createdAtUTC" DESC, email LIMIT $2 OFFSET $3`,
+		search, filter.PageSize, (filter.Page-1)*filter.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	users := make([]model.User, 0, filter.PageSize)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, *user)
+	}
+
+	return users, total, rows.Err()
+}
+
+func (s *service) SoftDeleteUser(ctx context.Context, userID string) error {
+	return s.updateUser(ctx, `UPDATE users SET "deletedAtUTC" = NOW(), "updatedAtUTC" = NOW() WHERE "ID" = $1 AND "deletedAtUTC" IS NULL`, userID)
+}
+
+func (s *service) RestoreUser(ctx context.Context, userID string) error {
+	return s.updateUser(ctx, `UPDATE users SET "deletedAtUTC" = NULL, "updatedAtUTC" = NOW() WHERE "ID" = $1 AND "deletedAtUTC" IS NOT NULL
+		AND "anonymizedAtUTC" IS NULL`, userID)
+}
+
+func (s *service) LockUser(ctx context.Context, userID string) error {
+	return s.updateUser(ctx, `UPDATE users SET "lockedAtUTC" = NOW(), "updatedAtUTC" = NOW() WHERE "ID" = $1 AND "lockedAtUTC" IS NULL`, userID)
+}
+
+func (s *service) UnlockUser(ctx context.Context, userID string) error {
+	return s.updateUser(ctx, `UPDATE users SET "lockedAtUTC" = NULL, "updatedAtUTC" = NOW() WHERE "ID" = $1 AND "lockedAtUTC" IS NOT NULL`, userID)
+}
+
+func (s *service) RequirePasswordReset(ctx context.Context, userID string) error {
+	return s.updateUser(ctx, `UPDATE users SET "passwordResetRequired" = TRUE, "updatedAtUTC" = NOW() WHERE "ID" = $1 AND "deletedAtUTC" IS NULL`, userID)
+}
+
+// PurgeDeletedUsers permanently removes the users deleted longer than the grace period ago, returning how many were purged.
+// Anonymized users keep their row without any personal data, everything linked to them is deleted.
+func (s *service) PurgeDeletedUsers(ctx context.Context, gracePeriod time.Duration, anonymize bool) (int64, error) {
+	if !anonymize {
+		result, err := s.db.ExecContext(ctx, `DELETE FROM users WHERE "deletedAtUTC" < NOW() - $1 * INTERVAL '1 second'`, gracePeriod.Seconds())
+		if err != nil {
+			return 0, err
+		}
+		return result.RowsAffected()
+	}
+
+	var purged int64
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `UPDATE users SET email = "ID"::text || '@invalid', name = '', password = '',
+			"anonymizedAtUTC" = NOW(), "updatedAtUTC" = NOW() WHERE "deletedAtUTC" < NOW() - $1 * INTERVAL '1 second' AND "anonymizedAtUTC" IS NULL RETURNING "ID"`,
+			gracePeriod.Seconds())
+		if err != nil {
+			return err
+		}
+		userIDs := make([]string, 0)
+		for rows.Next() {
+			var userID string
+			if err := rows.Scan(&userID); err != nil {
+				rows.Close()
+				return err
+			}
+			userIDs = append(userIDs, userID)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, table := range userDataTables {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE "userID" = ANY($1)`, pq.Array(userIDs)); err != nil {
+				return err
+			}
+		}
+		purged = int64(len(userIDs))
+		return nil
+	})
+
+	return purged, err
+}
+
+// updateUser runs an update on a single user, returning sql.ErrNoRows when no user was in the expected state.
+// The user id is the first query argument.
+func (s *service) updateUser(ctx context.Context, query, userID string, args ...any) error {
+	result, err := s.db.ExecContext(ctx, query, append([]any{userID}, args...)...)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// escapeLike escapes the pattern characters of a LIKE search term.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
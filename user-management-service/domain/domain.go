@@ -6,16 +6,56 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"user-management-service/model"
 
 	"github.com/lib/pq"
 )
 
+// userColumns are the selected columns of the users table in the order scanUser reads them.
+const userColumns = `"ID", email, name, password, "createdAtUTC", "updatedAtUTC", "deletedAtUTC", "lockedAtUTC", "passwordResetRequired"`
+
+var (
+	// ErrUserLocked when the account of the user has been locked by an administrator.
+	ErrUserLocked = errors.New("user account is locked")
+	// ErrTOTPAlreadyEnabled when enrolling totp for a user who already confirmed an enrollment.
+	ErrTOTPAlreadyEnabled = errors.New("totp is already enabled")
+	// ErrEmailTaken when changing the email of a user to the email of another account.
+	ErrEmailTaken = errors.New("email is already in use")
+)
+
 type Service interface {
 	CreateUser(ctx context.Context, user model.User) error
 	GetUser(ctx context.Context, userID string) (*model.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUsers(ctx context.Context, userIDs []string) ([]model.User, error)
+	UpdatePassword(ctx context.Context, email, password string) error
+	RehashPassword(ctx context.Context, userID, oldHash, newHash string) error
+	UpdateName(ctx context.Context, userID, name string) error
+	ChangeEmail(ctx context.Context, userID, email string) error
+	ListRoles(ctx context.Context) ([]model.Role, error)
+	GetUserRoles(ctx context.Context, userID string) ([]string, error)
+	GetUserPermissions(ctx context.Context, userID string) ([]string, error)
+	AssignRole(ctx context.Context, userID, role string) error
+	RemoveRole(ctx context.Context, userID, role string) error
+	ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error)
+	SoftDeleteUser(ctx context.Context, userID string) error
+	RestoreUser(ctx context.Context, userID string) error
+	PurgeDeletedUsers(ctx context.Context, gracePeriod time.Duration, anonymize bool) (int64, error)
+	LockUser(ctx context.Context, userID string) error
+	UnlockUser(ctx context.Context, userID string) error
+	RequirePasswordReset(ctx context.Context, userID string) error
+	GetTOTP(ctx context.Context, userID string) (*model.TOTP, error)
+	SaveTOTPSecret(ctx context.Context, userID, encryptedSecret string) error
+	EnableTOTP(ctx context.Context, userID string, recoveryCodeHashes []string) error
+	DisableTOTP(ctx context.Context, userID string) error
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+	PasskeyStore
+	DeletePasskey(ctx context.Context, userID string, credentialID []byte) error
+	IdentityStore
+	GetIdentities(ctx context.Context, userID string) ([]model.Identity, error)
+	GetPasswordHistory(ctx context.Context, email string, limit int) ([]string, error)
 }
 
 type service struct {
@@ -26,7 +66,10 @@ func (s *service) CreateUser(ctx context.Context, user model.User) error {
 	parts := strings.Split(user.Email, "@")
 	names := parts[:len(parts)-2]
 	name := strings.Join(names, "")
-	_, err := s.db.ExecContext(ctx, `INSERT INTO users(email, name, password) values($1, $2, $3)`, user.Email, name, user.Password)
+	_, err := s.db.ExecContext(ctx, `WITH created AS (INSERT INTO users(email, name, password) values($1, $2, $3) RETURNING "ID"),
+		history AS (INSERT INTO password_history("userID", "passwordHash") SELECT "ID", $3 FROM created)
+		INSERT INTO user_roles("userID", "roleID") SELECT created."ID", roles."ID" FROM created, roles WHERE roles."name" = $4`,
+		user.Email, name, user.Password, DefaultRole)
 	if err != nil {
 		var pqErr *pq.Error
 		if errors.As(err, &pqErr) {
@@ -41,20 +84,108 @@ func (s *service) CreateUser(ctx context.Context, user model.User) error {
 	return nil
 }
 
+// GetUser returns the user with the given id, including soft-deleted and locked users.
 func (s *service) GetUser(ctx context.Context, userID string) (*model.User, error) {
-	var user model.User
-	if err := s.db.QueryRowContext(ctx, `SELECT * FROM users where "ID" = $1`, userID).
-		Scan(&user.ID, &user.Email, &user.Name, &user.Password, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt); err != nil {
+	return scanUser(s.db.QueryRowContext(ctx, `SELECT `+userColumns+` FROM users where "ID" = $1`, userID))
+}
+
+// GetUserByEmail returns the active user with the given email, soft-deleted users are not found
+// and locked users are rejected with ErrUserLocked. The locked user is returned along with the error
+// so that logins can verify the password before telling that the account is locked.
+func (s *service) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	user, err := scanUser(s.db.QueryRowContext(ctx, `SELECT `+userColumns+` FROM users where email = $1 AND "deletedAtUTC" IS NULL`, email))
+	if err != nil {
 		return nil, err
 	}
+	if user.LockedAt != nil {
+		return user, ErrUserLocked
+	}
 
-	return &user, nil
+	return user, nil
 }
 
-func (s *service) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+func (s *service) GetUsers(ctx context.Context, userIDs []string) ([]model.User, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+userColumns+` FROM users where "ID" = ANY($1) AND "deletedAtUTC" IS NULL`, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]model.User, 0, len(userIDs))
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	return users, rows.Err()
+}
+
+// UpdatePassword sets the password of the user and adds it to their password history.
+func (s *service) UpdatePassword(ctx context.Context, email, password string) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		var userID string
+		if err := tx.QueryRowContext(ctx, `UPDATE users SET password = $1, "passwordResetRequired" = FALSE, "updatedAtUTC" = NOW() where email = $2 RETURNING "ID"`,
+			password, email).Scan(&userID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO password_history("userID", "passwordHash") VALUES($1, $2)`, userID, password)
+		return err
+	})
+}
+
+// RehashPassword replaces the password hash of the user with a hash of the same password under the current
+// hashing configuration. The hash is only replaced while it is still oldHash, so a concurrent password change wins.
+func (s *service) RehashPassword(ctx context.Context, userID, oldHash, newHash string) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE users SET password = $1 WHERE "ID" = $2 AND password = $3`, newHash, userID, oldHash)
+	return err
+}
+
+// UpdateName sets the display name of an active user.
+func (s *service) UpdateName(ctx context.Context, userID, name string) error {
+	return s.updateUser(ctx, `UPDATE users SET name = $2, "updatedAtUTC" = NOW() WHERE "ID" = $1 AND "deletedAtUTC" IS NULL`, userID, name)
+}
+
+// ChangeEmail sets the verified new email of an active user, ErrEmailTaken when another account has the email.
+func (s *service) ChangeEmail(ctx context.Context, userID, email string) error {
+	err := s.updateUser(ctx, `UPDATE users SET email = $2, "updatedAtUTC" = NOW() WHERE "ID" = $1 AND "deletedAtUTC" IS NULL`, userID, email)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return ErrEmailTaken
+	}
+
+	return err
+}
+
+// GetPasswordHistory returns the latest password hashes of the active user with the given email, newest first.
+// The history of soft-deleted users is not taken into account.
+func (s *service) GetPasswordHistory(ctx context.Context, email string, limit int) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT h."passwordHash" FROM password_history h JOIN users u ON u."ID" = h."userID"
+		WHERE u.email = $1 AND u."deletedAtUTC" IS NULL ORDER BY h."createdAtUTC" DESC LIMIT $2`, email, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hashes := make([]string, 0, limit)
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, rows.Err()
+}
+
+// scanUser reads a row selected with userColumns.
+func scanUser(row interface{ Scan(dest ...any) error }) (*model.User, error) {
 	var user model.User
-	row := s.db.QueryRowContext(ctx, `SELECT * FROM users where email = $1`, email)
-	if err := row.Scan(&user.ID, &user.Email, &user.Name, &user.Password, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt); err != nil {
+	if err := row.Scan(&user.ID, &user.Email, &user.Name, &user.Password, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt,
+		&user.LockedAt, &user.PasswordResetRequired); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,346 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/oauth2"
+
+	"user-management-service/config"
+	umsConstants "user-management-service/constants"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// maxEmailLength is the length of the email column of the users table.
+const maxEmailLength = 50
+
+var (
+	// ErrUnknownProvider when the identity provider is not configured.
+	ErrUnknownProvider = errors.New("unknown identity provider")
+	// ErrFederationStateExpired when the state of a federated login is unknown or expired.
+	ErrFederationStateExpired = errors.New("federated login expired")
+	// ErrUpstreamLogin when the upstream provider rejected the login or returned an invalid id token.
+	ErrUpstreamLogin = errors.New("upstream login failed")
+	// ErrUnverifiedEmail when a new identity has no verified email to link or provision a user with.
+	ErrUnverifiedEmail = errors.New("upstream identity has no verified email")
+	// ErrLinkConfirmationRequired when a new identity has the email of an existing user, it is linked once the user
+	// logged in to the existing account for the same login challenge.
+	ErrLinkConfirmationRequired = errors.New("upstream identity has to be confirmed by logging in to the existing user")
+	// ErrEmailUnavailable when the email of a new identity belongs to a deleted user.
+	ErrEmailUnavailable = errors.New("email of upstream identity is not available")
+)
+
+// IdentityStore links upstream identities to users.
+type IdentityStore interface {
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByIdentity(ctx context.Context, provider, subject string) (*model.User, error)
+	LinkIdentity(ctx context.Context, identity model.Identity) error
+	CreateFederatedUser(ctx context.Context, user model.User, identity model.Identity) (*model.User, error)
+}
+
+// FederatedAuthentication is the result of a verified federated login.
+type FederatedAuthentication struct {
+	User           *model.User
+	LoginChallenge string
+	Remember       bool
+}
+
+// Federation runs logins with upstream OpenID Connect identity providers using the authorization code flow with PKCE.
+type Federation struct {
+	providers   map[string]*identityProvider
+	store       IdentityStore
+	redisClient *redis.Client
+	httpClient  *http.Client
+	redirectURL string
+	stateTTL    time.Duration
+}
+
+// identityProvider discovers its upstream configuration on first use, so an unavailable
+// provider doesn't keep the service from starting.
+type identityProvider struct {
+	config   config.IdentityProvider
+	mu       sync.Mutex
+	verifier *oidc.IDTokenVerifier
+	oauth2   *oauth2.Config
+}
+
+// pendingFederatedLogin is the state of a login redirected to an upstream provider.
+type pendingFederatedLogin struct {
+	Provider       string `json:"provider"`
+	LoginChallenge string `json:"loginChallenge"`
+	Remember       bool   `json:"remember"`
+	Nonce          string `json:"nonce"`
+	CodeVerifier   string `json:"codeVerifier"`
+}
+
+// upstreamClaims are the id token claims used to link and provision users.
+type upstreamClaims struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// Begin returns the authorization url of the upstream provider for the oauth2 login challenge. Whether the user asked
+// to be remembered is kept with the state until the login is finished.
+func (f *Federation) Begin(ctx context.Context, providerName, loginChallenge string, remember bool) (string, error) {
+	provider, err := f.provider(ctx, providerName)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	pending := pendingFederatedLogin{
+		Provider:       providerName,
+		LoginChallenge: loginChallenge,
+		Remember:       remember,
+		Nonce:          nonce,
+		CodeVerifier:   oauth2.GenerateVerifier(),
+	}
+
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	pendingBytes, _ := json.Marshal(pending)
+	if err := f.redisClient.Set(ctx, federationStateKey(state), pendingBytes, f.stateTTL).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store federated login state", slog.Any(constants.Error, err))
+		return "", err
+	}
+
+	return provider.oauth2.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(pending.CodeVerifier)), nil
+}
+
+// Finish exchanges the upstream authorization code, verifies the id token and returns the linked user. A new user is
+// provisioned for identities seen for the first time. When a user with the same verified email exists, the identity is
+// only linked once that user logged in for the login challenge, see ConfirmLink.
+func (f *Federation) Finish(ctx context.Context, state, code string) (*FederatedAuthentication, error) {
+	pendingBytes, err := f.redisClient.GetDel(ctx, federationStateKey(state)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrFederationStateExpired
+		}
+		slog.ErrorContext(ctx, "unable to fetch federated login state", slog.Any(constants.Error, err))
+		return nil, err
+	}
+	var pending pendingFederatedLogin
+	if err := json.Unmarshal(pendingBytes, &pending); err != nil {
+		return nil, err
+	}
+
+	provider, err := f.provider(ctx, pending.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := provider.oauth2.Exchange(oidc.ClientContext(ctx, f.httpClient), code, oauth2.VerifierOption(pending.CodeVerifier))
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to exchange upstream authorization code", slog.Any(constants.Error, err))
+		return nil, ErrUpstreamLogin
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		slog.ErrorContext(ctx, "upstream token response has no id token")
+		return nil, ErrUpstreamLogin
+	}
+	idToken, err := provider.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to verify upstream id token", slog.Any(constants.Error, err))
+		return nil, ErrUpstreamLogin
+	}
+	if idToken.Nonce != pending.Nonce {
+		slog.ErrorContext(ctx, "upstream id token nonce does not match")
+		return nil, ErrUpstreamLogin
+	}
+
+	var claims upstreamClaims
+	if err := idToken.Claims(&claims); err != nil {
+		slog.ErrorContext(ctx, "unable to decode upstream id token claims", slog.Any(constants.Error, err))
+		return nil, ErrUpstreamLogin
+	}
+
+	user, err := f.resolveUser(ctx, pending.Provider, pending.LoginChallenge, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FederatedAuthentication{
+		User:           user,
+		LoginChallenge: pending.LoginChallenge,
+		Remember:       pending.Remember,
+	}, nil
+}
+
+// ConfirmLink links the identity waiting for the login challenge once the user it was matched to by email logged in,
+// identities matched to another user are discarded.
+func (f *Federation) ConfirmLink(ctx context.Context, loginChallenge, userID string) error {
+	identityBytes, err := f.redisClient.GetDel(ctx, federationLinkKey(loginChallenge)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+		slog.ErrorContext(ctx, "unable to fetch pending identity link", slog.Any(constants.Error, err))
+		return err
+	}
+	var identity model.Identity
+	if err := json.Unmarshal(identityBytes, &identity); err != nil {
+		return err
+	}
+	if identity.UserID != userID {
+		slog.ErrorContext(ctx, "login challenge of pending identity link was used by another user")
+		return nil
+	}
+
+	if err := f.store.LinkIdentity(ctx, identity); err != nil {
+		slog.ErrorContext(ctx, "unable to link upstream identity", slog.Any(constants.Error, err))
+		return err
+	}
+	slog.InfoContext(ctx, "linked upstream identity to existing user")
+
+	return nil
+}
+
+func (f *Federation) resolveUser(ctx context.Context, providerName, loginChallenge string, claims upstreamClaims) (*model.User, error) {
+	user, err := f.store.GetUserByIdentity(ctx, providerName, claims.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		slog.ErrorContext(ctx, "unable to fetch user of upstream identity", slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	email := claims.Email
+	if !claims.EmailVerified || len(email) == 0 || len(email) > maxEmailLength {
+		slog.ErrorContext(ctx, "upstream identity has no usable verified email")
+		return nil, ErrUnverifiedEmail
+	}
+	identity := model.Identity{
+		Provider: providerName,
+		Subject:  claims.Subject,
+		Email:    email,
+	}
+
+	user, err = f.store.GetUserByEmail(ctx, email)
+	switch {
+	case err == nil:
+		// a verified email at the upstream provider is not proof enough to take over an existing account.
+		identity.UserID = user.ID
+		// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+		identityBytes, _ := json.Marshal(identity)
+		if err := f.redisClient.Set(ctx, federationLinkKey(loginChallenge), identityBytes, f.stateTTL).Err(); err != nil {
+			slog.ErrorContext(ctx, "unable to store pending identity link", slog.Any(constants.Error, err))
+			return nil, err
+		}
+		slog.InfoContext(ctx, "upstream identity waits for a login of the existing user")
+		return nil, ErrLinkConfirmationRequired
+	case errors.Is(err, ErrUserLocked):
+		return nil, err
+	case errors.Is(err, sql.ErrNoRows):
+		// no user with this email yet, provisioned below.
+	default:
+		return nil, err
+	}
+
+	name := claims.Name
+	if len(name) == 0 {
+		name = strings.Split(email, "@")[0]
+	}
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	user, err = f.store.CreateFederatedUser(ctx, model.User{Email: email, Name: name}, identity)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to provision user for upstream identity", slog.Any(constants.Error, err))
+		return nil, err
+	}
+	slog.InfoContext(ctx, "provisioned user for upstream identity")
+
+	return user, nil
+}
+
+// provider returns the configured provider, discovering its endpoints and keys on first use.
+func (f *Federation) provider(ctx context.Context, name string) (*identityProvider, error) {
+	provider, ok := f.providers[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	if provider.verifier != nil {
+		return provider, nil
+	}
+
+	discovery, err := oidc.NewProvider(oidc.ClientContext(ctx, f.httpClient), provider.config.IssuerURL)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to discover identity provider", slog.String("provider", name), slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	scopes := provider.config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"email", "profile"}
+	}
+	provider.oauth2 = &oauth2.Config{
+		ClientID:     provider.config.ClientID,
+		ClientSecret: provider.config.ClientSecret,
+		Endpoint:     discovery.Endpoint(),
+		RedirectURL:  f.redirectURL,
+		Scopes:       append([]string{oidc.ScopeOpenID}, scopes...),
+	}
+	provider.verifier = discovery.Verifier(&oidc.Config{ClientID: provider.config.ClientID})
+
+	return provider, nil
+}
+
+func randomToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+func federationStateKey(state string) string {
+	return strings.Join([]string{umsConstants.FederationStateKeyPrefix, state}, ":")
+}
+
+func federationLinkKey(loginChallenge string) string {
+	return strings.Join([]string{umsConstants.FederationLinkKeyPrefix, loginChallenge}, ":")
+}
+
+// NewFederation creates the federated logins of the configured upstream identity providers.
+func NewFederation(federationConfig config.Federation, store IdentityStore, redisClient *redis.Client, httpClient *http.Client) *Federation {
+	providers := make(map[string]*identityProvider, len(federationConfig.Providers))
+	for _, provider := range federationConfig.Providers {
+		providers[provider.Name] = &identityProvider{config: provider}
+	}
+
+	return &Federation{
+		providers:   providers,
+		store:       store,
+		redisClient: redisClient,
+		httpClient:  httpClient,
+		redirectURL: federationConfig.RedirectURL,
+		stateTTL:    time.Duration(federationConfig.StateExpirySeconds) * time.Second,
+	}
+}
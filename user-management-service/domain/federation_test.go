@@ -0,0 +1,320 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+)
+
+const (
+	testProvider    = "mock"
+	testClientID    = "user-service"
+	testRedirectURL = "http://localhost:3000/login/callback"
+)
+
+// mockOIDCProvider is a minimal OpenID Connect provider issuing RS256 id tokens for a single upstream user,
+// it only accepts authorization codes redeemed with the PKCE verifier of the authorization request.
+type mockOIDCProvider struct {
+	*httptest.Server
+	t   *testing.T
+	key *rsa.PrivateKey
+
+	mu             sync.Mutex
+	authorizations map[string]url.Values
+	subject        string
+	email          string
+	emailVerified  bool
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &mockOIDCProvider{t: t, key: key, authorizations: map[string]url.Values{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", provider.discovery)
+	mux.HandleFunc("/jwks", provider.keySet)
+	mux.HandleFunc("/authorize", provider.authorize)
+	mux.HandleFunc("/token", provider.token)
+	provider.Server = httptest.NewServer(mux)
+	t.Cleanup(provider.Close)
+
+	return provider
+}
+
+func (p *mockOIDCProvider) setUser(subject, email string, emailVerified bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subject, p.email, p.emailVerified = subject, email, emailVerified
+}
+
+func (p *mockOIDCProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                p.URL,
+		"authorization_endpoint":                p.URL + "/authorize",
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *mockOIDCProvider) keySet(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(jwks.KeySet{Keys: []jwks.JSONWebKey{{
+		KeyID:   "key",
+		KeyType: "RSA",
+		N:       base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+	}}})
+}
+
+// authorize signs the user in right away and redirects back with an authorization code.
+func (p *mockOIDCProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("client_id") != testClientID {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	code := uuid.NewString()
+	p.mu.Lock()
+	p.authorizations[code] = query
+	p.mu.Unlock()
+
+	redirect, _ := url.Parse(query.Get("redirect_uri"))
+	redirect.RawQuery = url.Values{"code": {code}, "state": {query.Get("state")}}.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *mockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	authorization, ok := p.authorizations[r.PostFormValue("code")]
+	delete(p.authorizations, r.PostFormValue("code"))
+	challenge := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(challenge[:]) != authorization.Get("code_challenge") {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            p.URL,
+		"aud":            testClientID,
+		"sub":            p.subject,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          authorization.Get("nonce"),
+		"email":          p.email,
+		"email_verified": p.emailVerified,
+		"amr":            []string{"pwd"},
+	})
+	idToken.Header["kid"] = "key"
+	signed, err := idToken.SignedString(p.key)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "upstream-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     signed,
+	})
+}
+
+// login follows the authorization url like a browser and returns the code and state posted back by the login app.
+func (p *mockOIDCProvider) login(authURL string) (string, string) {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	response, err := client.Get(authURL)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusFound {
+		p.t.Fatalf("expected redirect from upstream provider, got %d", response.StatusCode)
+	}
+	location, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		p.t.Fatal(err)
+	}
+
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+type fakeIdentityStore struct {
+	users      map[string]*model.User
+	identities map[string]string
+	created    int
+}
+
+func (f *fakeIdentityStore) GetUserByEmail(_ context.Context, email string) (*model.User, error) {
+	for _, user := range f.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (f *fakeIdentityStore) GetUserByIdentity(_ context.Context, provider, subject string) (*model.User, error) {
+	userID, ok := f.identities[provider+"|"+subject]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return f.users[userID], nil
+}
+
+func (f *fakeIdentityStore) LinkIdentity(_ context.Context, identity model.Identity) error {
+	f.identities[identity.Provider+"|"+identity.Subject] = identity.UserID
+	return nil
+}
+
+func (f *fakeIdentityStore) CreateFederatedUser(_ context.Context, user model.User, identity model.Identity) (*model.User, error) {
+	f.created++
+	user.ID = uuid.NewString()
+	f.users[user.ID] = &user
+	f.identities[identity.Provider+"|"+identity.Subject] = user.ID
+	return &user, nil
+}
+
+func newTestFederation(t *testing.T, provider *mockOIDCProvider, store IdentityStore) *Federation {
+	redisServer := miniredis.RunT(t)
+	return NewFederation(config.Federation{
+		RedirectURL:        testRedirectURL,
+		StateExpirySeconds: 600,
+		Providers: []config.IdentityProvider{{
+			Name:      testProvider,
+			IssuerURL: provider.URL,
+			ClientID:  testClientID,
+		}},
+	}, store, redis.NewClient(&redis.Options{Addr: redisServer.Addr()}), provider.Client())
+}
+
+// federatedLogin signs in with the user of the mock provider for the login challenge.
+func federatedLogin(t *testing.T, federation *Federation, provider *mockOIDCProvider, loginChallenge string) (*FederatedAuthentication, error) {
+	t.Helper()
+	authURL, err := federation.Begin(context.Background(), testProvider, loginChallenge, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, state := provider.login(authURL)
+
+	return federation.Finish(context.Background(), state, code)
+}
+
+func TestFederatedLoginProvisionsUsers(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	store := &fakeIdentityStore{users: map[string]*model.User{}, identities: map[string]string{}}
+	federation := newTestFederation(t, provider, store)
+
+	login := func(loginChallenge string) *FederatedAuthentication {
+		authentication, err := federatedLogin(t, federation, provider, loginChallenge)
+		if err != nil {
+			t.Fatalf("expected federated login to succeed, got %v", err)
+		}
+		if authentication.LoginChallenge != loginChallenge || !authentication.Remember {
+			t.Fatalf("expected login challenge %s to be remembered, got %+v", loginChallenge, authentication)
+		}
+		return authentication
+	}
+
+	provider.setUser("upstream-new", "new@cisauth.org", true)
+	provisioned := login("first-challenge").User
+	if provisioned.Email != "new@cisauth.org" || provisioned.Name != "new" || store.created != 1 {
+		t.Fatalf("expected user to be provisioned, got %+v", provisioned)
+	}
+	if again := login("second-challenge").User; again.ID != provisioned.ID || store.created != 1 {
+		t.Fatalf("expected linked identity to sign in the provisioned user, got %+v", again)
+	}
+}
+
+func TestFederatedLoginLinksExistingUserOnceConfirmed(t *testing.T) {
+	ctx := context.Background()
+	provider := newMockOIDCProvider(t)
+	existing := &model.User{ID: uuid.NewString(), Email: "existing@cisauth.org"}
+	store := &fakeIdentityStore{users: map[string]*model.User{existing.ID: existing}, identities: map[string]string{}}
+	federation := newTestFederation(t, provider, store)
+
+	provider.setUser("upstream-existing", existing.Email, true)
+	if _, err := federatedLogin(t, federation, provider, "first-challenge"); !errors.Is(err, ErrLinkConfirmationRequired) {
+		t.Fatalf("expected the link to the existing user to wait for a confirmation, got %v", err)
+	}
+	if err := federation.ConfirmLink(ctx, "first-challenge", uuid.NewString()); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.identities) != 0 {
+		t.Fatal("expected a login of another user not to link the identity")
+	}
+	if err := federation.ConfirmLink(ctx, "first-challenge", existing.ID); err != nil || len(store.identities) != 0 {
+		t.Fatalf("expected the pending link to be used up by the first login, got %v", err)
+	}
+
+	if _, err := federatedLogin(t, federation, provider, "second-challenge"); !errors.Is(err, ErrLinkConfirmationRequired) {
+		t.Fatalf("expected the link to wait for a confirmation again, got %v", err)
+	}
+	if err := federation.ConfirmLink(ctx, "second-challenge", existing.ID); err != nil {
+		t.Fatal(err)
+	}
+	linked, err := federatedLogin(t, federation, provider, "third-challenge")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if linked.User.ID != existing.ID || store.created != 0 {
+		t.Fatalf("expected the confirmed identity to sign in the existing user, got %+v", linked.User)
+	}
+}
+
+func TestFederatedLoginRejectsInvalidLogins(t *testing.T) {
+	ctx := context.Background()
+	provider := newMockOIDCProvider(t)
+	store := &fakeIdentityStore{users: map[string]*model.User{}, identities: map[string]string{}}
+	federation := newTestFederation(t, provider, store)
+
+	if _, err := federation.Begin(ctx, "unknown", "challenge", false); !errors.Is(err, ErrUnknownProvider) {
+		t.Fatalf("expected unknown provider to be rejected, got %v", err)
+	}
+
+	provider.setUser("upstream-unverified", "unverified@cisauth.org", false)
+	authURL, err := federation.Begin(ctx, testProvider, "challenge", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, state := provider.login(authURL)
+	if _, err := federation.Finish(ctx, state, code); !errors.Is(err, ErrUnverifiedEmail) {
+		t.Fatalf("expected unverified email to be rejected, got %v", err)
+	}
+	if _, err := federation.Finish(ctx, state, code); !errors.Is(err, ErrFederationStateExpired) {
+		t.Fatalf("expected replayed state to be rejected, got %v", err)
+	}
+	if len(store.users) != 0 {
+		t.Fatalf("expected no user to be provisioned, got %d", len(store.users))
+	}
+}
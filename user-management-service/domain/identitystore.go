@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	"user-management-service/model"
+)
+
+// GetUserByIdentity returns the active user linked to the subject of an upstream identity provider.
+func (s *service) GetUserByIdentity(ctx context.Context, provider, subject string) (*model.User, error) {
+	return scanUser(s.db.QueryRowContext(ctx, `SELECT `+userColumns+` FROM users WHERE "deletedAtUTC" IS NULL
+		AND "ID" = (SELECT "userID" FROM user_identities WHERE provider = $1 AND subject = $2)`, provider, subject))
+}
+
+// GetIdentities returns the upstream identities linked to a user.
+func (s *service) GetIdentities(ctx context.Context, userID string) ([]model.Identity, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT provider, subject, "userID", COALESCE(email, ''), "createdAtUTC" FROM user_identities
+		WHERE "userID" = $1 ORDER BY "createdAtUTC"`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := make([]model.Identity, 0)
+	for rows.Next() {
+		var identity model.Identity
+		if err := rows.Scan(&identity.Provider, &identity.Subject, &identity.UserID, &identity.Email, &identity.CreatedAt); err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+
+	return identities, rows.Err()
+}
+
+// LinkIdentity links the subject of an upstream identity provider to an existing user.
+func (s *service) LinkIdentity(ctx context.Context, identity model.Identity) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO user_identities(provider, subject, "userID", email) VALUES($1, $2, $3, $4)
+		ON CONFLICT (provider, subject) DO NOTHING`, identity.Provider, identity.Subject, identity.UserID, identity.Email)
+	return err
+}
+
+// CreateFederatedUser provisions a user signing in with an upstream identity provider for the first time.
+// Provisioned users have no password, they can set one through the forgot password flow.
+func (s *service) CreateFederatedUser(ctx context.Context, user model.User, identity model.Identity) (*model.User, error) {
+	var created *model.User
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		created, err = scanUser(tx.QueryRowContext(ctx, `INSERT INTO users(email, name, password) VALUES($1, $2, '') RETURNING `+userColumns,
+			user.Email, user.Name))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO user_roles("userID", "roleID") SELECT $1::uuid, "ID" FROM roles WHERE name = $2`,
+			created.ID, DefaultRole); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `INSERT INTO user_identities(provider, subject, "userID", email) VALUES($1, $2, $3, $4)`,
+			identity.Provider, identity.Subject, created.ID, identity.Email)
+		return err
+	})
+	if err != nil {
+		// soft-deleted users keep their email until they are purged.
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "users_pkey" {
+			return nil, ErrEmailUnavailable
+		}
+		return nil, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	umsConstants "user-management-service/constants"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// loginCodeDigits is the number of digits of a one-time login code.
+const loginCodeDigits = 6
+
+var (
+	// ErrLoginCodeInvalid when no code was sent to the email for the login challenge, it expired or it does not match.
+	ErrLoginCodeInvalid = errors.New("login code is invalid or expired")
+	// ErrLoginCodeAttempts when too many codes were tried for the login challenge.
+	ErrLoginCodeAttempts = errors.New("too many attempts for login code")
+)
+
+// LoginCodes issues and verifies the one-time codes of passwordless logins. A code is bound to the login challenge it
+// was sent for and can be tried a few times, wrong codes also count as failed logins of the account and ip address so
+// requesting new codes does not allow guessing without limit.
+type LoginCodes struct {
+	redisClient *redis.Client
+	config      config.EmailOTP
+	throttle    *LoginThrottle
+}
+
+// Issue creates a code for the login challenge which is sent to the email. No code is returned while the previous code
+// sent to the email is within the resend interval.
+func (l *LoginCodes) Issue(ctx context.Context, email, loginChallenge string) (string, error) {
+	resendInterval := time.Duration(l.config.ResendIntervalSeconds) * time.Second
+	allowed, err := l.redisClient.SetNX(ctx, loginCodeSentKey(email), 1, resendInterval).Result()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to throttle login codes", slog.Any(constants.Error, err))
+		return "", err
+	}
+	if !allowed {
+		slog.InfoContext(ctx, "login code requested again within resend interval")
+		return "", nil
+	}
+
+	code, err := generateLoginCode()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to generate login code", slog.Any(constants.Error, err))
+		l.redisClient.Del(ctx, loginCodeSentKey(email))
+		return "", err
+	}
+
+	key := loginCodeKey(loginChallenge)
+	pipeline := l.redisClient.TxPipeline()
+	pipeline.Del(ctx, key)
+	pipeline.HSet(ctx, key, "email", email, "codeHash", hashLoginCode(loginChallenge, code), "attempts", 0)
+	pipeline.Expire(ctx, key, l.CodeExpiry())
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to store login code", slog.Any(constants.Error, err))
+		l.redisClient.Del(ctx, loginCodeSentKey(email))
+		return "", err
+	}
+
+	return code, nil
+}
+
+// Withdraw deletes the code of the login challenge and lifts the resend interval after the code could not be sent.
+func (l *LoginCodes) Withdraw(ctx context.Context, email, loginChallenge string) {
+	if err := l.redisClient.Del(ctx, loginCodeKey(loginChallenge), loginCodeSentKey(email)).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to withdraw login code", slog.Any(constants.Error, err))
+	}
+}
+
+// Verify checks the code sent for the login challenge and returns the email it was sent to. Emails are compared
+// case-insensitively. Wrong codes are recorded as failed logins, a verified code is used up. The failed logins are
+// kept, they are only forgotten once the login is accepted.
+func (l *LoginCodes) Verify(ctx context.Context, email, loginChallenge, code, ipAddress string) (string, error) {
+	key := loginCodeKey(loginChallenge)
+	pending, err := l.redisClient.HGetAll(ctx, key).Result()
+	if err != nil || len(pending["codeHash"]) == 0 || !strings.EqualFold(pending["email"], email) {
+		slog.ErrorContext(ctx, "login code not found", slog.Any(constants.Error, err))
+		return "", ErrLoginCodeInvalid
+	}
+
+	attempts, err := l.redisClient.HIncrBy(ctx, key, "attempts", 1).Result()
+	if err != nil || attempts > int64(l.config.MaxAttempts) {
+		slog.ErrorContext(ctx, "too many attempts for login code", slog.Any(constants.Error, err))
+		l.redisClient.Del(ctx, key)
+		return "", ErrLoginCodeAttempts
+	}
+
+	codeHash := hashLoginCode(loginChallenge, code)
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(pending["codeHash"])) != 1 {
+		if _, err := l.throttle.Fail(ctx, email, ipAddress); err != nil {
+			slog.ErrorContext(ctx, "unable to record failed login", slog.Any(constants.Error, err))
+		}
+		return "", ErrLoginCodeInvalid
+	}
+
+	l.redisClient.Del(ctx, key)
+
+	return pending["email"], nil
+}
+
+// CodeExpiry is how long a sent code can be used.
+func (l *LoginCodes) CodeExpiry() time.Duration {
+	return time.Duration(l.config.CodeExpirySeconds) * time.Second
+}
+
+// generateLoginCode returns a random numeric code of loginCodeDigits digits.
+func generateLoginCode() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(loginCodeDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", loginCodeDigits, n), nil
+}
+
+// hashLoginCode binds the code to the login challenge it was sent for.
+func hashLoginCode(loginChallenge, code string) string {
+	sum := sha256.Sum256([]byte(loginChallenge + ":" + strings.TrimSpace(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+func loginCodeKey(loginChallenge string) string {
+	return strings.Join([]string{umsConstants.LoginCodeKeyPrefix, loginChallenge}, ":")
+}
+
+func loginCodeSentKey(email string) string {
+	return strings.Join([]string{umsConstants.LoginCodeSentKeyPrefix, strings.ToLower(email)}, ":")
+}
+
+// NewLoginCodes creates the one-time login codes, wrong codes are counted by the login throttle.
+func NewLoginCodes(emailOTP config.EmailOTP, redisClient *redis.Client, throttle *LoginThrottle) *LoginCodes {
+	return &LoginCodes{
+		redisClient: redisClient,
+		config:      emailOTP,
+		throttle:    throttle,
+	}
+}
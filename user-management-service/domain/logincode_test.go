@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+)
+
+func newTestLoginCodes(t *testing.T) (*LoginCodes, *LoginThrottle, *miniredis.Miniredis) {
+	t.Helper()
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	throttle := NewLoginThrottle(config.LoginProtection{
+		MaxAccountFailures:   5,
+		MaxIPFailures:        100,
+		FailureWindowSeconds: 3600,
+		LockoutSeconds:       60,
+		MaxLockoutSeconds:    900,
+	}, redisClient)
+
+	return NewLoginCodes(config.EmailOTP{
+		CodeExpirySeconds:     300,
+		MaxAttempts:           3,
+		ResendIntervalSeconds: 30,
+	}, redisClient, throttle), throttle, redisServer
+}
+
+func TestLoginCodeIsVerifiedOnceForTheEmailItWasSentTo(t *testing.T) {
+	ctx := context.Background()
+	loginCodes, _, _ := newTestLoginCodes(t)
+
+	code, err := loginCodes.Issue(ctx, "User@cisauth.org", "challenge")
+	if err != nil || len(code) != loginCodeDigits {
+		t.Fatalf("expected a code to be issued, got %q %v", code, err)
+	}
+
+	if _, err := loginCodes.Verify(ctx, "other@cisauth.org", "challenge", code, "10.0.0.1"); !errors.Is(err, ErrLoginCodeInvalid) {
+		t.Fatalf("expected the code of another email to be rejected, got %v", err)
+	}
+	if _, err := loginCodes.Verify(ctx, "user@cisauth.org", "other", code, "10.0.0.1"); !errors.Is(err, ErrLoginCodeInvalid) {
+		t.Fatalf("expected the code of another login challenge to be rejected, got %v", err)
+	}
+
+	email, err := loginCodes.Verify(ctx, "USER@cisauth.org", "challenge", code, "10.0.0.1")
+	if err != nil {
+		t.Fatalf("expected the code to be verified regardless of the email case, got %v", err)
+	}
+	if email != "User@cisauth.org" {
+		t.Fatalf("expected the email the code was sent to, got %q", email)
+	}
+	if _, err := loginCodes.Verify(ctx, "user@cisauth.org", "challenge", code, "10.0.0.1"); !errors.Is(err, ErrLoginCodeInvalid) {
+		t.Fatalf("expected a verified code to be used up, got %v", err)
+	}
+}
+
+func TestLoginCodeAttemptsAreLimitedPerCodeAndAccount(t *testing.T) {
+	ctx := context.Background()
+	loginCodes, throttle, redisServer := newTestLoginCodes(t)
+
+	code, err := loginCodes.Issue(ctx, "user@cisauth.org", "challenge")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := loginCodes.Verify(ctx, "user@cisauth.org", "challenge", "000000x", "10.0.0.1"); !errors.Is(err, ErrLoginCodeInvalid) {
+			t.Fatalf("attempt %d: expected a wrong code to be rejected, got %v", i+1, err)
+		}
+	}
+	if _, err := loginCodes.Verify(ctx, "user@cisauth.org", "challenge", code, "10.0.0.1"); !errors.Is(err, ErrLoginCodeAttempts) {
+		t.Fatalf("expected the code to be given up after the maximum attempts, got %v", err)
+	}
+
+	// new codes don't reset the failures of the account, they are counted across codes until the lockout.
+	for i := 0; i < 2; i++ {
+		if code, _ := loginCodes.Issue(ctx, "user@cisauth.org", "challenge"); len(code) != 0 {
+			t.Fatal("expected no new code within the resend interval")
+		}
+		redisServer.FastForward(30 * time.Second)
+		if code, err := loginCodes.Issue(ctx, "user@cisauth.org", "challenge"); err != nil || len(code) == 0 {
+			t.Fatalf("expected a new code after the resend interval, got %v", err)
+		}
+		if _, err := loginCodes.Verify(ctx, "USER@cisauth.org", "challenge", "000000x", "10.0.0.2"); !errors.Is(err, ErrLoginCodeInvalid) {
+			t.Fatalf("expected a wrong code to be rejected, got %v", err)
+		}
+	}
+	lockout, err := throttle.Lockout(ctx, "user@cisauth.org", "10.0.0.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lockout == 0 {
+		t.Fatal("expected the account to be locked out after repeated wrong codes")
+	}
+}
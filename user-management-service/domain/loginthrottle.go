@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	umsConstants "user-management-service/constants"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+const (
+	accountScope = "account"
+	ipScope      = "ip"
+)
+
+// LoginThrottle counts failed logins per account and per ip address, whether their password or a code was wrong.
+// Once a limit is reached further attempts are refused for a lockout which doubles with every failure, so guessing
+// slows down progressively.
+type LoginThrottle struct {
+	redisClient *redis.Client
+	config      config.LoginProtection
+}
+
+// Lockout returns how long password logins of the account or from the ip address are refused, zero when allowed.
+func (l *LoginThrottle) Lockout(ctx context.Context, email, ipAddress string) (time.Duration, error) {
+	pipeline := l.redisClient.Pipeline()
+	account := pipeline.PTTL(ctx, throttleKey(umsConstants.LoginLockoutKeyPrefix, accountScope, email))
+	ip := pipeline.PTTL(ctx, throttleKey(umsConstants.LoginLockoutKeyPrefix, ipScope, ipAddress))
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to fetch login lockout", slog.Any(constants.Error, err))
+		return 0, err
+	}
+
+	// PTTL is negative for keys which don't exist.
+	return max(account.Val(), ip.Val(), 0), nil
+}
+
+// Fail records a failed login and locks the account or blocks the ip address once its limit is reached.
+// It reports whether the account got locked by this failure for the first time in the failure window.
+func (l *LoginThrottle) Fail(ctx context.Context, email, ipAddress string) (bool, error) {
+	accountFailures, err := l.fail(ctx, accountScope, email, l.config.MaxAccountFailures)
+	if err != nil {
+		return false, err
+	}
+	if _, err := l.fail(ctx, ipScope, ipAddress, l.config.MaxIPFailures); err != nil {
+		return false, err
+	}
+
+	return accountFailures == int64(l.config.MaxAccountFailures), nil
+}
+
+// Reset forgets the failed logins of the account after a successful login, failures of the ip address are kept.
+func (l *LoginThrottle) Reset(ctx context.Context, email string) error {
+	return l.redisClient.Del(ctx,
+		throttleKey(umsConstants.LoginFailuresKeyPrefix, accountScope, email),
+		throttleKey(umsConstants.LoginLockoutKeyPrefix, accountScope, email),
+	).Err()
+}
+
+func (l *LoginThrottle) fail(ctx context.Context, scope, value string, maxFailures int) (int64, error) {
+	key := throttleKey(umsConstants.LoginFailuresKeyPrefix, scope, value)
+	pipeline := l.redisClient.TxPipeline()
+	failures := pipeline.Incr(ctx, key)
+	pipeline.Expire(ctx, key, time.Duration(l.config.FailureWindowSeconds)*time.Second)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to record failed login", slog.Any(constants.Error, err))
+		return 0, err
+	}
+	if failures.Val() < int64(maxFailures) {
+		return failures.Val(), nil
+	}
+
+	lockout := l.lockout(failures.Val() - int64(maxFailures))
+	if err := l.redisClient.Set(ctx, throttleKey(umsConstants.LoginLockoutKeyPrefix, scope, value), failures.Val(), lockout).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store login lockout", slog.Any(constants.Error, err))
+		return 0, err
+	}
+	slog.InfoContext(ctx, "failed login limit reached", slog.String("scope", scope), slog.Duration("lockout", lockout))
+
+	return failures.Val(), nil
+}
+
+// lockout doubles the base lockout for every failure beyond the limit, capped at the maximum lockout.
+func (l *LoginThrottle) lockout(failuresOverLimit int64) time.Duration {
+	lockout := time.Duration(l.config.LockoutSeconds) * time.Second
+	maxLockout := time.Duration(l.config.MaxLockoutSeconds) * time.Second
+	for i := int64(0); i < failuresOverLimit && lockout < maxLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, maxLockout)
+}
+
+func throttleKey(prefix, scope, value string) string {
+	return strings.Join([]string{prefix, scope, strings.ToLower(value)}, ":")
+}
+
+// NewLoginThrottle creates the failed login tracking with the configured limits.
+func NewLoginThrottle(loginProtection config.LoginProtection, redisClient *redis.Client) *LoginThrottle {
+	return &LoginThrottle{
+		redisClient: redisClient,
+		config:      loginProtection,
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+)
+
+func TestLoginThrottleLocksAccountWithProgressiveBackoff(t *testing.T) {
+	ctx := context.Background()
+	redisServer := miniredis.RunT(t)
+	throttle := NewLoginThrottle(config.LoginProtection{
+		MaxAccountFailures:   3,
+		MaxIPFailures:        10,
+		FailureWindowSeconds: 900,
+		LockoutSeconds:       60,
+		MaxLockoutSeconds:    200,
+	}, redis.NewClient(&redis.Options{Addr: redisServer.Addr()}))
+
+	const email, ip = "user@cisauth.org", "10.0.0.1"
+	expectedLockouts := []time.Duration{0, 0, time.Minute, 2 * time.Minute, 200 * time.Second}
+	for i, expected := range expectedLockouts {
+		locked, err := throttle.Fail(ctx, email, ip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if locked != (i == 2) {
+			t.Fatalf("failure %d: expected account to be reported locked only when the limit is reached", i+1)
+		}
+		lockout, err := throttle.Lockout(ctx, "USER@cisauth.org", "10.0.0.2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lockout != expected {
+			t.Fatalf("failure %d: expected lockout %s, got %s", i+1, expected, lockout)
+		}
+	}
+
+	if err := throttle.Reset(ctx, email); err != nil {
+		t.Fatal(err)
+	}
+	if lockout, _ := throttle.Lockout(ctx, email, ip); lockout != 0 {
+		t.Fatalf("expected reset to lift the account lockout, got %s", lockout)
+	}
+}
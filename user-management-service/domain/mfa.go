@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+
+	"user-management-service/model"
+)
+
+// GetTOTP returns the totp enrollment of a user, sql.ErrNoRows when the user never enrolled.
+func (s *service) GetTOTP(ctx context.Context, userID string) (*model.TOTP, error) {
+	var totp model.TOTP
+	if err := s.db.QueryRowContext(ctx, `SELECT "userID", "encryptedSecret", enabled, "confirmedAtUTC" FROM user_totp WHERE "userID" = $1`, userID).
+		Scan(&totp.UserID, &totp.EncryptedSecret, &totp.Enabled, &totp.ConfirmedAt); err != nil {
+		return nil, err
+	}
+
+	return &totp, nil
+}
+
+// SaveTOTPSecret starts a new totp enrollment, replacing an enrollment which was not confirmed yet.
+func (s *service) SaveTOTPSecret(ctx context.Context, userID, encryptedSecret string) error {
+	result, err := s.db.ExecContext(ctx, `INSERT INTO user_totp("userID", "encryptedSecret") VALUES($1, $2)
+		ON CONFLICT ("userID") DO UPDATE SET "encryptedSecret" = EXCLUDED."encryptedSecret", "createdAtUTC" = NOW()
+		WHERE user_totp.enabled = FALSE`, userID, encryptedSecret)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrTOTPAlreadyEnabled
+	}
+
+	return nil
+}
+
+// EnableTOTP confirms the totp enrollment and replaces the recovery codes of the user.
+func (s *service) EnableTOTP(ctx context.Context, userID string, recoveryCodeHashes []string) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `UPDATE user_totp SET enabled = TRUE, "confirmedAtUTC" = NOW() WHERE "userID" = $1`, userID); err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes)
+	})
+}
+
+// DisableTOTP removes the totp enrollment and recovery codes of the user.
+func (s *service) DisableTOTP(ctx context.Context, userID string) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM user_totp WHERE "userID" = $1`, userID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE "userID" = $1`, userID)
+		return err
+	})
+}
+
+// UseRecoveryCode marks an unused recovery code as used, sql.ErrNoRows when no such code is available.
+func (s *service) UseRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE user_recovery_codes SET "usedAtUTC" = NOW() WHERE "userID" = $1 AND "codeHash" = $2 AND "usedAtUTC" IS NULL`, userID, codeHash)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID string, recoveryCodeHashes []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE "userID" = $1`, userID); err != nil {
+		return err
+	}
+	for _, codeHash := range recoveryCodeHashes {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO user_recovery_codes("userID", "codeHash") VALUES($1, $2)`, userID, codeHash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withTx runs fn in a transaction, rolling back when it fails.
+func (s *service) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
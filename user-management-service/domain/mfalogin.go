@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	umsConstants "user-management-service/constants"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+const (
+	// recoveryCodeCount is how many recovery codes are generated on totp enrollment.
+	recoveryCodeCount = 10
+	// recoveryCodeLength is the number of characters of a recovery code, shown in two dash separated groups.
+	recoveryCodeLength = 10
+	// totpPeriod is the validity period of a totp code, a used code is remembered for the whole skew window.
+	totpPeriod = 30 * time.Second
+)
+
+var (
+	// ErrInvalidMFACode when a totp code is wrong or was already used, or a recovery code is unknown or used.
+	ErrInvalidMFACode = errors.New("invalid mfa code")
+	// ErrMFALoginExpired when no login is waiting for its second factor under the mfa token.
+	ErrMFALoginExpired = errors.New("pending mfa login is invalid or expired")
+	// ErrMFALoginAttempts when too many codes were tried for a pending login.
+	ErrMFALoginAttempts = errors.New("too many attempts for pending mfa login")
+)
+
+// RecoveryCodeStore uses up the recovery codes of users.
+type RecoveryCodeStore interface {
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+}
+
+// PendingMFALogin is a login verified with its first factor which waits for its second factor.
+type PendingMFALogin struct {
+	MFAToken       string
+	UserID         string
+	LoginChallenge string
+	// FirstFactor is the amr of the verified first factor.
+	FirstFactor string
+	Remember    bool
+}
+
+// MFALogins holds logins verified with their first factor until a totp or recovery code is verified. A pending login
+// can be tried a few times, wrong codes also count as failed logins of the account and ip address so a stolen
+// password does not allow guessing the second factor without limit.
+type MFALogins struct {
+	redisClient   *redis.Client
+	config        config.MFA
+	throttle      *LoginThrottle
+	recoveryCodes RecoveryCodeStore
+}
+
+// Start holds the login until its second factor is verified and returns the mfa token completing it.
+func (m *MFALogins) Start(ctx context.Context, login PendingMFALogin) (string, error) {
+	mfaToken := uuid.NewString()
+	key := pendingMFALoginKey(mfaToken)
+
+	pipeline := m.redisClient.TxPipeline()
+	pipeline.HSet(ctx, key, "userID", login.UserID, "loginChallenge", login.LoginChallenge, "factor", login.FirstFactor,
+		"remember", strconv.FormatBool(login.Remember), "attempts", 0)
+	pipeline.Expire(ctx, key, time.Duration(m.config.PendingLoginExpirySeconds)*time.Second)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "unable to store pending mfa login", slog.Any(constants.Error, err))
+		return "", err
+	}
+
+	return mfaToken, nil
+}
+
+// Get returns the login waiting for its second factor under the mfa token.
+func (m *MFALogins) Get(ctx context.Context, mfaToken string) (*PendingMFALogin, error) {
+	pending, err := m.redisClient.HGetAll(ctx, pendingMFALoginKey(mfaToken)).Result()
+	if err != nil || len(pending["userID"]) == 0 {
+		slog.ErrorContext(ctx, "pending mfa login not found", slog.Any(constants.Error, err))
+		return nil, ErrMFALoginExpired
+	}
+
+	firstFactor := pending["factor"]
+	if len(firstFactor) == 0 {
+		firstFactor = constants.AMRPassword
+	}
+	remember, _ := strconv.ParseBool(pending["remember"])
+
+	return &PendingMFALogin{
+		MFAToken:       mfaToken,
+		UserID:         pending["userID"],
+		LoginChallenge: pending["loginChallenge"],
+		FirstFactor:    firstFactor,
+		Remember:       remember,
+	}, nil
+}
+
+// Cancel drops the pending login, its login has to be started again.
+func (m *MFALogins) Cancel(ctx context.Context, mfaToken string) {
+	if err := m.redisClient.Del(ctx, pendingMFALoginKey(mfaToken)).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to delete pending mfa login", slog.Any(constants.Error, err))
+	}
+}
+
+// Complete verifies the second factor of the pending login of the user with the email and returns the amr of the
+// login. Wrong codes are recorded as failed logins, it reports whether the failure locked the account. The pending
+// login is used up once verified and dropped once out of attempts or its account got locked.
+func (m *MFALogins) Complete(ctx context.Context, login *PendingMFALogin, email, secret, code, ipAddress string) ([]string, bool, error) {
+	key := pendingMFALoginKey(login.MFAToken)
+	attempts, err := m.redisClient.HIncrBy(ctx, key, "attempts", 1).Result()
+	if err != nil || attempts > int64(m.config.MaxAttempts) {
+		slog.ErrorContext(ctx, "too many mfa attempts for pending login", slog.Any(constants.Error, err))
+		m.Cancel(ctx, login.MFAToken)
+		return nil, false, ErrMFALoginAttempts
+	}
+
+	amr, err := m.Verify(ctx, login.UserID, secret, code, login.FirstFactor)
+	if errors.Is(err, ErrInvalidMFACode) {
+		locked, err := m.throttle.Fail(ctx, email, ipAddress)
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to record failed login", slog.Any(constants.Error, err))
+		}
+		if locked {
+			m.Cancel(ctx, login.MFAToken)
+		}
+		return nil, locked, ErrInvalidMFACode
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	m.Cancel(ctx, login.MFAToken)
+
+	return amr, false, nil
+}
+
+// Verify checks a totp code or uses up a recovery code of the user and returns the amr of a login with the first
+// factor, wrong or used codes are rejected with ErrInvalidMFACode.
+func (m *MFALogins) Verify(ctx context.Context, userID, secret, code, firstFactor string) ([]string, error) {
+	amr := []string{firstFactor}
+	if isTOTPCode(code) {
+		if err := m.VerifyTOTP(ctx, userID, secret, code); err != nil {
+			return nil, err
+		}
+		if firstFactor != constants.AMROneTimePassword {
+			amr = append(amr, constants.AMROneTimePassword)
+		}
+		return append(amr, constants.AMRMultiFactor), nil
+	}
+
+	if err := m.recoveryCodes.UseRecoveryCode(ctx, userID, hashRecoveryCode(code)); err != nil {
+		slog.ErrorContext(ctx, "recovery code is invalid or used", slog.Any(constants.Error, err))
+		return nil, ErrInvalidMFACode
+	}
+	slog.InfoContext(ctx, "recovery code used for second factor")
+
+	return append(amr, constants.AMRMultiFactor), nil
+}
+
+// VerifyTOTP validates the code against the totp secret of the user, each code can only be used once.
+func (m *MFALogins) VerifyTOTP(ctx context.Context, userID, secret, code string) error {
+	if !totp.Validate(code, secret) {
+		slog.ErrorContext(ctx, "totp code is invalid")
+		return ErrInvalidMFACode
+	}
+
+	// the code stays valid for the neighbouring periods, remember it so it can't be replayed.
+	usedKey := strings.Join([]string{umsConstants.UsedTOTPKeyPrefix, userID, code}, ":")
+	firstUse, err := m.redisClient.SetNX(ctx, usedKey, 1, 3*totpPeriod).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		slog.ErrorContext(ctx, "unable to record used totp code", slog.Any(constants.Error, err))
+		return err
+	}
+	if !firstUse {
+		slog.ErrorContext(ctx, "totp code was already used")
+		return ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+// GenerateRecoveryCodes returns new recovery codes along with their hashes to be stored.
+func GenerateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		random := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(random); err != nil {
+			return nil, nil, err
+		}
+		code := base32.StdEncoding.EncodeToString(random)[:recoveryCodeLength]
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode hashes a recovery code ignoring case, spaces and dashes.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func pendingMFALoginKey(mfaToken string) string {
+	return strings.Join([]string{umsConstants.PendingMFALoginKeyPrefix, mfaToken}, ":")
+}
+
+// NewMFALogins creates the logins waiting for their second factor, wrong codes are counted by the login throttle.
+func NewMFALogins(mfa config.MFA, redisClient *redis.Client, throttle *LoginThrottle, recoveryCodes RecoveryCodeStore) *MFALogins {
+	return &MFALogins{
+		redisClient:   redisClient,
+		config:        mfa,
+		throttle:      throttle,
+		recoveryCodes: recoveryCodes,
+	}
+}
@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/pquerna/otp/totp"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+type fakeRecoveryCodes struct {
+	unused map[string]bool
+}
+
+func (f *fakeRecoveryCodes) UseRecoveryCode(_ context.Context, _, codeHash string) error {
+	if !f.unused[codeHash] {
+		return sql.ErrNoRows
+	}
+	delete(f.unused, codeHash)
+	return nil
+}
+
+func newTestMFALogins(t *testing.T, maxAttempts, maxAccountFailures int) (*MFALogins, *LoginThrottle, *fakeRecoveryCodes) {
+	t.Helper()
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	throttle := NewLoginThrottle(config.LoginProtection{
+		MaxAccountFailures:   maxAccountFailures,
+		MaxIPFailures:        100,
+		FailureWindowSeconds: 900,
+		LockoutSeconds:       60,
+		MaxLockoutSeconds:    900,
+	}, redisClient)
+	recoveryCodes := &fakeRecoveryCodes{unused: map[string]bool{}}
+
+	return NewMFALogins(config.MFA{
+		PendingLoginExpirySeconds: 300,
+		MaxAttempts:               maxAttempts,
+	}, redisClient, throttle, recoveryCodes), throttle, recoveryCodes
+}
+
+func newTestTOTPSecret(t *testing.T) string {
+	t.Helper()
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: "cisauth", AccountName: "user@cisauth.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key.Secret()
+}
+
+func TestMFALoginTOTPCodeCannotBeReplayed(t *testing.T) {
+	ctx := context.Background()
+	mfaLogins, _, _ := newTestMFALogins(t, 5, 5)
+	secret := newTestTOTPSecret(t)
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amr, err := mfaLogins.Verify(ctx, "user", secret, code, constants.AMRPassword)
+	if err != nil {
+		t.Fatalf("expected the totp code to be verified, got %v", err)
+	}
+	if strings.Join(amr, " ") != strings.Join([]string{constants.AMRPassword, constants.AMROneTimePassword, constants.AMRMultiFactor}, " ") {
+		t.Fatalf("expected password, otp and mfa amr, got %v", amr)
+	}
+	if _, err := mfaLogins.Verify(ctx, "user", secret, code, constants.AMRPassword); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("expected a used totp code to be rejected, got %v", err)
+	}
+	if _, err := mfaLogins.Verify(ctx, "other", secret, code, constants.AMRPassword); err != nil {
+		t.Fatalf("expected used codes to be remembered per user, got %v", err)
+	}
+}
+
+func TestMFALoginRejectsWrongCodes(t *testing.T) {
+	ctx := context.Background()
+	mfaLogins, _, _ := newTestMFALogins(t, 5, 5)
+	secret := newTestTOTPSecret(t)
+	code, err := totp.GenerateCode(secret, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := mfaLogins.Verify(ctx, "user", secret, code, constants.AMRPassword); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("expected an outdated totp code to be rejected, got %v", err)
+	}
+	if _, err := mfaLogins.Verify(ctx, "user", secret, "ABCDE-FGHIJ", constants.AMRPassword); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("expected an unknown recovery code to be rejected, got %v", err)
+	}
+}
+
+func TestMFALoginRecoveryCodeIsUsedOnce(t *testing.T) {
+	ctx := context.Background()
+	mfaLogins, _, recoveryCodes := newTestMFALogins(t, 5, 5)
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, hash := range hashes {
+		recoveryCodes.unused[hash] = true
+	}
+
+	// recovery codes are accepted regardless of case and dashes.
+	amr, err := mfaLogins.Verify(ctx, "user", newTestTOTPSecret(t), strings.ToLower(strings.ReplaceAll(codes[0], "-", "")), constants.AMROneTimePassword)
+	if err != nil {
+		t.Fatalf("expected the recovery code to be verified, got %v", err)
+	}
+	if strings.Join(amr, " ") != strings.Join([]string{constants.AMROneTimePassword, constants.AMRMultiFactor}, " ") {
+		t.Fatalf("expected otp and mfa amr, got %v", amr)
+	}
+	if _, err := mfaLogins.Verify(ctx, "user", newTestTOTPSecret(t), codes[0], constants.AMROneTimePassword); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("expected a used recovery code to be rejected, got %v", err)
+	}
+	if len(recoveryCodes.unused) != len(codes)-1 {
+		t.Fatalf("expected only the used recovery code to be used up, %d left", len(recoveryCodes.unused))
+	}
+}
+
+func TestMFALoginIsCompletedOnce(t *testing.T) {
+	ctx := context.Background()
+	mfaLogins, _, _ := newTestMFALogins(t, 5, 5)
+	secret := newTestTOTPSecret(t)
+	mfaToken, err := mfaLogins.Start(ctx, PendingMFALogin{UserID: "user", LoginChallenge: "challenge", FirstFactor: constants.AMRPassword, Remember: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := mfaLogins.Get(ctx, mfaToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending.UserID != "user" || pending.LoginChallenge != "challenge" || !pending.Remember {
+		t.Fatalf("expected the started login, got %+v", pending)
+	}
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := mfaLogins.Complete(ctx, pending, "user@cisauth.org", secret, code, "10.0.0.1"); err != nil {
+		t.Fatalf("expected the login to be completed, got %v", err)
+	}
+	if _, err := mfaLogins.Get(ctx, mfaToken); !errors.Is(err, ErrMFALoginExpired) {
+		t.Fatalf("expected a completed login to be used up, got %v", err)
+	}
+}
+
+func TestMFALoginAttemptsAreLimitedPerLoginAndAccount(t *testing.T) {
+	ctx := context.Background()
+	mfaLogins, throttle, _ := newTestMFALogins(t, 2, 3)
+	secret := newTestTOTPSecret(t)
+	start := func() *PendingMFALogin {
+		mfaToken, err := mfaLogins.Start(ctx, PendingMFALogin{UserID: "user", LoginChallenge: "challenge", FirstFactor: constants.AMRPassword})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pending, err := mfaLogins.Get(ctx, mfaToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pending
+	}
+
+	pending := start()
+	for i := 0; i < 2; i++ {
+		if _, locked, err := mfaLogins.Complete(ctx, pending, "user@cisauth.org", secret, "000000", "10.0.0.1"); !errors.Is(err, ErrInvalidMFACode) || locked {
+			t.Fatalf("attempt %d: expected a wrong code to be rejected without locking the account, got %v", i+1, err)
+		}
+	}
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := mfaLogins.Complete(ctx, pending, "user@cisauth.org", secret, code, "10.0.0.1"); !errors.Is(err, ErrMFALoginAttempts) {
+		t.Fatalf("expected the login to be out of attempts, got %v", err)
+	}
+	if _, err := mfaLogins.Get(ctx, pending.MFAToken); !errors.Is(err, ErrMFALoginExpired) {
+		t.Fatalf("expected a login out of attempts to be dropped, got %v", err)
+	}
+
+	// the failures of earlier logins count, starting a new login does not allow guessing without limit.
+	pending = start()
+	_, locked, err := mfaLogins.Complete(ctx, pending, "user@cisauth.org", secret, "000000", "10.0.0.1")
+	if !errors.Is(err, ErrInvalidMFACode) || !locked {
+		t.Fatalf("expected the third wrong code to lock the account, got %v", err)
+	}
+	if _, err := mfaLogins.Get(ctx, pending.MFAToken); !errors.Is(err, ErrMFALoginExpired) {
+		t.Fatalf("expected the login of a locked account to be dropped, got %v", err)
+	}
+	lockout, err := throttle.Lockout(ctx, "user@cisauth.org", "10.0.0.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lockout == 0 {
+		t.Fatal("expected the account to be locked out after repeated wrong codes")
+	}
+}
@@ -0,0 +1,299 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	umsConstants "user-management-service/constants"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+var (
+	// ErrCeremonyExpired when the challenge of a passkey ceremony is unknown or expired.
+	ErrCeremonyExpired = errors.New("passkey ceremony expired")
+	// ErrPasskeyInvalid when the authenticator response can't be verified.
+	ErrPasskeyInvalid = errors.New("passkey response is invalid")
+)
+
+// PasskeyStore persists the WebAuthn credentials of users.
+type PasskeyStore interface {
+	GetUser(ctx context.Context, userID string) (*model.User, error)
+	GetPasskeys(ctx context.Context, userID string) ([]model.Passkey, error)
+	SavePasskey(ctx context.Context, passkey model.Passkey) error
+	UpdatePasskeyUsage(ctx context.Context, credential webauthn.Credential) error
+}
+
+// PasskeyAuthentication is the result of a verified passkey login.
+type PasskeyAuthentication struct {
+	User           *model.User
+	LoginChallenge string
+	Acr            string
+	Amr            []string
+	Remember       bool
+}
+
+// Passkeys runs the WebAuthn registration and login ceremonies, challenge state is kept in redis until
+// the authenticator responds or the challenge expires.
+type Passkeys struct {
+	webAuthn     *webauthn.WebAuthn
+	store        PasskeyStore
+	redisClient  *redis.Client
+	challengeTTL time.Duration
+}
+
+// pendingPasskeyLogin is the challenge state of a passkey login.
+type pendingPasskeyLogin struct {
+	Session        webauthn.SessionData `json:"session"`
+	LoginChallenge string               `json:"loginChallenge"`
+	Remember       bool                 `json:"remember"`
+}
+
+// passkeyUser adapts a user and their credentials to webauthn.User.
+type passkeyUser struct {
+	user        *model.User
+	credentials []webauthn.Credential
+}
+
+func (u *passkeyUser) WebAuthnID() []byte                         { return []byte(u.user.ID) }
+func (u *passkeyUser) WebAuthnName() string                       { return u.user.Email }
+func (u *passkeyUser) WebAuthnDisplayName() string                { return u.user.Name }
+func (u *passkeyUser) WebAuthnIcon() string                       { return "" }
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential { return u.credentials }
+
+// BeginRegistration creates the options for registering a new passkey of the user.
+func (p *Passkeys) BeginRegistration(ctx context.Context, userID string) (*protocol.CredentialCreation, error) {
+	user, err := p.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(user.credentials))
+	for _, credential := range user.credentials {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+
+	creation, session, err := p.webAuthn.BeginRegistration(user,
+		webauthn.WithExclusions(exclusions),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to begin passkey registration", slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	if err := p.saveState(ctx, registrationKey(userID), session); err != nil {
+		return nil, err
+	}
+
+	return creation, nil
+}
+
+// FinishRegistration verifies the attestation of the authenticator and stores the new passkey.
+func (p *Passkeys) FinishRegistration(ctx context.Context, userID, name string, response io.Reader) (*model.Passkey, error) {
+	var session webauthn.SessionData
+	if err := p.takeState(ctx, registrationKey(userID), &session); err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(response)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to parse passkey registration response", slog.Any(constants.Error, err))
+		return nil, ErrPasskeyInvalid
+	}
+
+	user, err := p.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := p.webAuthn.CreateCredential(user, session, parsed)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to verify passkey registration", slog.Any(constants.Error, err))
+		return nil, ErrPasskeyInvalid
+	}
+
+	if len(name) == 0 {
+		name = "Passkey"
+	}
+	passkey := model.Passkey{
+		ID:         credential.ID,
+		UserID:     userID,
+		Name:       name,
+		Credential: *credential,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := p.store.SavePasskey(ctx, passkey); err != nil {
+		slog.ErrorContext(ctx, "unable to save passkey", slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "successfully registered passkey")
+
+	return &passkey, nil
+}
+
+// BeginLogin creates the options of a passwordless login with a discoverable passkey for the oauth2 login challenge.
+// User verification is required, a passkey login replaces both the password and the second factor. Whether the user
+// asked to be remembered is kept with the challenge until the login is finished.
+func (p *Passkeys) BeginLogin(ctx context.Context, loginChallenge string, remember bool) (*protocol.CredentialAssertion, error) {
+	assertion, session, err := p.webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to begin passkey login", slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	if err := p.saveState(ctx, loginKey(session.Challenge), pendingPasskeyLogin{
+		Session:        *session,
+		LoginChallenge: loginChallenge,
+		Remember:       remember,
+	}); err != nil {
+		return nil, err
+	}
+
+	return assertion, nil
+}
+
+// FinishLogin verifies the assertion of the authenticator and returns the user along with how they authenticated.
+// Device-bound credentials are reported with the hwk amr, synced credentials with swk.
+func (p *Passkeys) FinishLogin(ctx context.Context, response io.Reader) (*PasskeyAuthentication, error) {
+	parsed, err := protocol.ParseCredentialRequestResponseBody(response)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to parse passkey login response", slog.Any(constants.Error, err))
+		return nil, ErrPasskeyInvalid
+	}
+
+	var pending pendingPasskeyLogin
+	if err := p.takeState(ctx, loginKey(parsed.Response.CollectedClientData.Challenge), &pending); err != nil {
+		return nil, err
+	}
+
+	var user *passkeyUser
+	credential, err := p.webAuthn.ValidateDiscoverableLogin(func(_, userHandle []byte) (webauthn.User, error) {
+		user, err = p.loadUser(ctx, string(userHandle))
+		return user, err
+	}, pending.Session, parsed)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to verify passkey login", slog.Any(constants.Error, err))
+		return nil, ErrPasskeyInvalid
+	}
+	if credential.Authenticator.CloneWarning {
+		slog.ErrorContext(ctx, "passkey sign counter went backwards, credential may be cloned")
+		return nil, ErrPasskeyInvalid
+	}
+	if !parsed.Response.AuthenticatorData.Flags.HasUserVerified() {
+		slog.ErrorContext(ctx, "passkey login without user verification")
+		return nil, ErrPasskeyInvalid
+	}
+
+	if err := p.store.UpdatePasskeyUsage(ctx, *credential); err != nil {
+		slog.ErrorContext(ctx, "unable to update passkey usage", slog.Any(constants.Error, err))
+	}
+
+	amr := []string{constants.AMRHardwareKey}
+	if credential.Flags.BackupEligible {
+		amr = []string{constants.AMRSoftwareKey}
+	}
+	// possession of the key plus a verified pin or biometric.
+	amr = append(amr, constants.AMRMultiFactor)
+
+	slog.InfoContext(ctx, "successfully verified passkey login")
+
+	return &PasskeyAuthentication{
+		User:           user.user,
+		LoginChallenge: pending.LoginChallenge,
+		Acr:            constants.ACRMultiFactor,
+		Amr:            amr,
+		Remember:       pending.Remember,
+	}, nil
+}
+
+func (p *Passkeys) loadUser(ctx context.Context, userID string) (*passkeyUser, error) {
+	user, err := p.store.GetUser(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch user for passkey ceremony", slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	passkeys, err := p.store.GetPasskeys(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch passkeys of user", slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	credentials := make([]webauthn.Credential, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		credentials = append(credentials, passkey.Credential)
+	}
+
+	return &passkeyUser{user: user, credentials: credentials}, nil
+}
+
+func (p *Passkeys) saveState(ctx context.Context, key string, state any) error {
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	stateBytes, _ := json.Marshal(state)
+	if err := p.redisClient.Set(ctx, key, stateBytes, p.challengeTTL).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store passkey ceremony state", slog.Any(constants.Error, err))
+		return err
+	}
+
+	return nil
+}
+
+// takeState loads and removes the ceremony state so that a challenge can only be answered once.
+func (p *Passkeys) takeState(ctx context.Context, key string, state any) error {
+	stateBytes, err := p.redisClient.GetDel(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrCeremonyExpired
+		}
+		slog.ErrorContext(ctx, "unable to fetch passkey ceremony state", slog.Any(constants.Error, err))
+		return err
+	}
+
+	return json.NewDecoder(bytes.NewReader(stateBytes)).Decode(state)
+}
+
+func registrationKey(userID string) string {
+	return strings.Join([]string{umsConstants.WebAuthnRegistrationKeyPrefix, userID}, ":")
+}
+
+func loginKey(challenge string) string {
+	return strings.Join([]string{umsConstants.WebAuthnLoginKeyPrefix, challenge}, ":")
+}
+
+// NewPasskeys creates the passkey ceremonies for the configured relying party.
+func NewPasskeys(webAuthnConfig config.WebAuthn, store PasskeyStore, redisClient *redis.Client) (*Passkeys, error) {
+	challengeTTL := time.Duration(webAuthnConfig.ChallengeExpirySeconds) * time.Second
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          webAuthnConfig.RPID,
+		RPDisplayName: webAuthnConfig.RPDisplayName,
+		RPOrigins:     webAuthnConfig.RPOrigins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        webauthn.TimeoutConfig{Enforce: true, Timeout: challengeTTL},
+			Registration: webauthn.TimeoutConfig{Enforce: true, Timeout: challengeTTL},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Passkeys{
+		webAuthn:     webAuthn,
+		store:        store,
+		redisClient:  redisClient,
+		challengeTTL: challengeTTL,
+	}, nil
+}
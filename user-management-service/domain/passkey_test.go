@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+const (
+	testRPID   = "localhost"
+	testOrigin = "http://localhost:3000"
+
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttested     = 0x40
+)
+
+type fakePasskeyStore struct {
+	user     model.User
+	passkeys map[string]model.Passkey
+}
+
+func (f *fakePasskeyStore) GetUser(_ context.Context, userID string) (*model.User, error) {
+	if userID != f.user.ID {
+		return nil, errors.New("user not found")
+	}
+	return &f.user, nil
+}
+
+func (f *fakePasskeyStore) GetPasskeys(context.Context, string) ([]model.Passkey, error) {
+	passkeys := make([]model.Passkey, 0, len(f.passkeys))
+	for _, passkey := range f.passkeys {
+		passkeys = append(passkeys, passkey)
+	}
+	return passkeys, nil
+}
+
+func (f *fakePasskeyStore) SavePasskey(_ context.Context, passkey model.Passkey) error {
+	f.passkeys[string(passkey.ID)] = passkey
+	return nil
+}
+
+func (f *fakePasskeyStore) UpdatePasskeyUsage(_ context.Context, credential webauthn.Credential) error {
+	passkey := f.passkeys[string(credential.ID)]
+	passkey.Credential = credential
+	f.passkeys[string(credential.ID)] = passkey
+	return nil
+}
+
+// softwareAuthenticator is a minimal ES256 authenticator producing "none" attestations.
+type softwareAuthenticator struct {
+	t            *testing.T
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	counter      uint32
+}
+
+func newSoftwareAuthenticator(t *testing.T) *softwareAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentialID := make([]byte, 16)
+	_, _ = rand.Read(credentialID)
+
+	return &softwareAuthenticator{t: t, key: key, credentialID: credentialID}
+}
+
+func (a *softwareAuthenticator) authenticatorData(flags byte, attestedCredential []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(testRPID))
+	data := append(rpIDHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.counter)
+	return append(data, attestedCredential...)
+}
+
+func (a *softwareAuthenticator) clientData(ceremony, challenge string) []byte {
+	clientData, _ := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    testOrigin,
+	})
+	return clientData
+}
+
+func (a *softwareAuthenticator) register(challenge string) []byte {
+	publicKey, err := cbor.Marshal(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.key.PublicKey.X.FillBytes(make([]byte, 32)),
+		-3: a.key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	attestedCredential := make([]byte, 16) // zero aaguid
+	attestedCredential = binary.BigEndian.AppendUint16(attestedCredential, uint16(len(a.credentialID)))
+	attestedCredential = append(attestedCredential, a.credentialID...)
+	attestedCredential = append(attestedCredential, publicKey...)
+
+	attestationObject, err := cbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authenticatorData(flagUserPresent|flagUserVerified|flagAttested, attestedCredential),
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.response(map[string]string{
+		"clientDataJSON":    encode(a.clientData("webauthn.create", challenge)),
+		"attestationObject": encode(attestationObject),
+	})
+}
+
+func (a *softwareAuthenticator) assert(challenge string, userHandle []byte) []byte {
+	return a.assertWithFlags(challenge, userHandle, flagUserPresent|flagUserVerified)
+}
+
+func (a *softwareAuthenticator) assertWithFlags(challenge string, userHandle []byte, flags byte) []byte {
+	a.counter++
+	authenticatorData := a.authenticatorData(flags, nil)
+	clientData := a.clientData("webauthn.get", challenge)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authenticatorData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.response(map[string]string{
+		"clientDataJSON":    encode(clientData),
+		"authenticatorData": encode(authenticatorData),
+		"signature":         encode(signature),
+		"userHandle":        encode(userHandle),
+	})
+}
+
+func (a *softwareAuthenticator) response(response map[string]string) []byte {
+	body, _ := json.Marshal(map[string]any{
+		"id":       encode(a.credentialID),
+		"rawId":    encode(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	return body
+}
+
+func encode(value []byte) string {
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+func TestPasskeyRegistrationAndLogin(t *testing.T) {
+	ctx := context.Background()
+	redisServer := miniredis.RunT(t)
+	store := &fakePasskeyStore{
+		user:     model.User{ID: "6f1c5b0e-3b8e-4f0a-9d51-2b7f0d5c9a11", Email: "user@cisauth.org", Name: "User"},
+		passkeys: map[string]model.Passkey{},
+	}
+	passkeys, err := NewPasskeys(config.WebAuthn{
+		RPID:                   testRPID,
+		RPDisplayName:          "cisauth",
+		RPOrigins:              []string{testOrigin},
+		ChallengeExpirySeconds: 300,
+	}, store, redis.NewClient(&redis.Options{Addr: redisServer.Addr()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authenticator := newSoftwareAuthenticator(t)
+
+	creation, err := passkeys.BeginRegistration(ctx, store.user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	passkey, err := passkeys.FinishRegistration(ctx, store.user.ID, "laptop", bytes.NewReader(authenticator.register(creation.Response.Challenge.String())))
+	if err != nil {
+		t.Fatalf("expected registration to succeed, got %v", err)
+	}
+	if !bytes.Equal(passkey.ID, authenticator.credentialID) || passkey.Name != "laptop" {
+		t.Fatalf("unexpected passkey %+v", passkey)
+	}
+
+	assertion, err := passkeys.BeginLogin(ctx, "login-challenge", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := authenticator.assert(assertion.Response.Challenge.String(), []byte(store.user.ID))
+	authentication, err := passkeys.FinishLogin(ctx, bytes.NewReader(response))
+	if err != nil {
+		t.Fatalf("expected login to succeed, got %v", err)
+	}
+	if authentication.User.ID != store.user.ID || authentication.LoginChallenge != "login-challenge" || !authentication.Remember {
+		t.Fatalf("unexpected authentication %+v", authentication)
+	}
+	if authentication.Acr != constants.ACRMultiFactor || authentication.Amr[0] != constants.AMRHardwareKey {
+		t.Fatalf("expected user verified hardware key, got acr %s amr %v", authentication.Acr, authentication.Amr)
+	}
+	if counter := store.passkeys[string(authenticator.credentialID)].Credential.Authenticator.SignCount; counter != 1 {
+		t.Fatalf("expected sign counter to be stored, got %d", counter)
+	}
+
+	if _, err := passkeys.FinishLogin(ctx, bytes.NewReader(response)); !errors.Is(err, ErrCeremonyExpired) {
+		t.Fatalf("expected replayed assertion to be rejected, got %v", err)
+	}
+
+	assertion, err = passkeys.BeginLogin(ctx, "login-challenge", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response = authenticator.assertWithFlags(assertion.Response.Challenge.String(), []byte(store.user.ID), flagUserPresent)
+	if _, err := passkeys.FinishLogin(ctx, bytes.NewReader(response)); !errors.Is(err, ErrPasskeyInvalid) {
+		t.Fatalf("expected assertion without user verification to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"user-management-service/model"
+)
+
+func (s *service) GetPasskeys(ctx context.Context, userID string) ([]model.Passkey, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT "ID", "userID", name, credential, "createdAtUTC", "lastUsedAtUTC"
+		FROM webauthn_credentials WHERE "userID" = $1 ORDER BY "createdAtUTC"`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	passkeys := make([]model.Passkey, 0)
+	for rows.Next() {
+		var (
+			passkey    model.Passkey
+			credential []byte
+		)
+		if err := rows.Scan(&passkey.ID, &passkey.UserID, &passkey.Name, &credential, &passkey.CreatedAt, &passkey.LastUsedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(credential, &passkey.Credential); err != nil {
+			return nil, err
+		}
+		passkeys = append(passkeys, passkey)
+	}
+
+	return passkeys, rows.Err()
+}
+
+func (s *service) SavePasskey(ctx context.Context, passkey model.Passkey) error {
+	credential, err := json.Marshal(passkey.Credential)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `INSERT INTO webauthn_credentials("ID", "userID", name, credential) VALUES($1, $2, $3, $4)`,
+		passkey.ID, passkey.UserID, passkey.Name, credential)
+	return err
+}
+
+// UpdatePasskeyUsage stores the sign counter and flags of a credential after a login.
+func (s *service) UpdatePasskeyUsage(ctx context.Context, credential webauthn.Credential) error {
+	credentialBytes, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `UPDATE webauthn_credentials SET credential = $1, "lastUsedAtUTC" = NOW() WHERE "ID" = $2`, credentialBytes, credential.ID)
+	return err
+}
+
+func (s *service) DeletePasskey(ctx context.Context, userID string, credentialID []byte) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM webauthn_credentials WHERE "userID" = $1 AND "ID" = $2`, userID, credentialID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"user-management-service/config"
+)
+
+const (
+	// BcryptAlgorithm hashes passwords with bcrypt, hashes are stored in the modular crypt format.
+	BcryptAlgorithm = "bcrypt"
+	// Argon2idAlgorithm hashes passwords with argon2id, hashes are stored in the PHC string format.
+	Argon2idAlgorithm = "argon2id"
+)
+
+// ErrUnsupportedHash when a stored password hash is of an unknown format.
+var ErrUnsupportedHash = errors.New("unsupported password hash format")
+
+// passwordHashFormat is a password hashing algorithm whose stored hashes carry their own parameters.
+type passwordHashFormat interface {
+	hash(password []byte) (string, error)
+	verify(encoded string, password []byte) (bool, error)
+	// weaker reports whether the hash uses weaker parameters than the format is configured with.
+	weaker(encoded string) (bool, error)
+}
+
+// PasswordHasher hashes new passwords with the configured algorithm and verifies hashes of every supported format,
+// so the algorithm or its cost can be changed and existing hashes are upgraded on the next login of their user.
+type PasswordHasher struct {
+	algorithm string
+	formats   map[string]passwordHashFormat
+	dummyHash string
+}
+
+// Hash hashes the password with the configured algorithm.
+func (h *PasswordHasher) Hash(password []byte) (string, error) {
+	return h.formats[h.algorithm].hash(password)
+}
+
+// Verify reports whether the password matches the hash. Empty hashes of users without a password, like users
+// provisioned by federated login, are unsupported but take as long to verify as the hash of any other user.
+func (h *PasswordHasher) Verify(encoded string, password []byte) (bool, error) {
+	if len(encoded) == 0 {
+		h.VerifyUnknownUser(password)
+		return false, ErrUnsupportedHash
+	}
+	format, err := h.format(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	return format.verify(encoded, password)
+}
+
+// VerifyUnknownUser does the work of verifying a password for a user that doesn't exist,
+// so response times don't tell unknown users apart from wrong passwords.
+func (h *PasswordHasher) VerifyUnknownUser(password []byte) {
+	_, _ = h.Verify(h.dummyHash, password)
+}
+
+// NeedsRehash reports whether the hash is of another algorithm or uses weaker parameters than configured.
+func (h *PasswordHasher) NeedsRehash(encoded string) bool {
+	algorithm, err := hashAlgorithm(encoded)
+	if err != nil || algorithm != h.algorithm {
+		return true
+	}
+	weaker, err := h.formats[algorithm].weaker(encoded)
+
+	return err != nil || weaker
+}
+
+func (h *PasswordHasher) format(encoded string) (passwordHashFormat, error) {
+	algorithm, err := hashAlgorithm(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.formats[algorithm], nil
+}
+
+func hashAlgorithm(encoded string) (string, error) {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		return Argon2idAlgorithm, nil
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		return BcryptAlgorithm, nil
+	}
+
+	return "", ErrUnsupportedHash
+}
+
+// NewPasswordHasher creates a hasher for the configured algorithm and parameters.
+func NewPasswordHasher(hashing config.PasswordHashing) (*PasswordHasher, error) {
+	hasher := &PasswordHasher{
+		algorithm: hashing.Algorithm,
+		formats: map[string]passwordHashFormat{
+			BcryptAlgorithm: bcryptFormat{cost: max(hashing.BcryptCost, bcrypt.DefaultCost)},
+			Argon2idAlgorithm: argon2idFormat{
+				memory:      hashing.Argon2Memory,
+				iterations:  hashing.Argon2Iterations,
+				parallelism: hashing.Argon2Parallelism,
+				saltLength:  hashing.Argon2SaltLength,
+				keyLength:   hashing.Argon2KeyLength,
+			},
+		},
+	}
+	if _, ok := hasher.formats[hashing.Algorithm]; !ok {
+		return nil, fmt.Errorf("unsupported password hash algorithm %q", hashing.Algorithm)
+	}
+	if hashing.Algorithm == Argon2idAlgorithm {
+		if err := hasher.formats[Argon2idAlgorithm].(argon2idFormat).validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	dummyHash, err := hasher.Hash([]byte("dummy password of unknown users"))
+	if err != nil {
+		return nil, err
+	}
+	hasher.dummyHash = dummyHash
+
+	return hasher, nil
+}
+
+type bcryptFormat struct {
+	cost int
+}
+
+func (f bcryptFormat) hash(password []byte) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword(password, f.cost)
+	return string(hash), err
+}
+
+func (f bcryptFormat) verify(encoded string, password []byte) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), password)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (f bcryptFormat) weaker(encoded string) (bool, error) {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return false, err
+	}
+
+	return cost < f.cost, nil
+}
+
+type argon2idFormat struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+// validate rejects parameters argon2id can't hash with or that are too weak to hash passwords with.
+func (f argon2idFormat) validate() error {
+	switch {
+	case f.parallelism == 0:
+		return errors.New("argon2id parallelism must be atleast 1")
+	case f.iterations == 0:
+		return errors.New("argon2id iterations must be atleast 1")
+	case f.memory < 8*uint32(f.parallelism):
+		return fmt.Errorf("argon2id memory must be atleast %d KiB for a parallelism of %d", 8*uint32(f.parallelism), f.parallelism)
+	case f.saltLength < 16:
+		return errors.New("argon2id salt length must be atleast 16 bytes")
+	case f.keyLength < 16:
+		return errors.New("argon2id key length must be atleast 16 bytes")
+	}
+
+	return nil
+}
+
+// argon2idHash is a decoded $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key> hash.
+type argon2idHash struct {
+	argon2idFormat
+	salt []byte
+	key  []byte
+}
+
+func (f argon2idFormat) hash(password []byte) (string, error) {
+	salt := make([]byte, f.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey(password, salt, f.iterations, f.memory, f.parallelism, f.keyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, f.memory, f.iterations, f.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (f argon2idFormat) verify(encoded string, password []byte) (bool, error) {
+	decoded, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+	key := argon2.IDKey(password, decoded.salt, decoded.iterations, decoded.memory, decoded.parallelism, decoded.keyLength)
+
+	return subtle.ConstantTimeCompare(key, decoded.key) == 1, nil
+}
+
+func (f argon2idFormat) weaker(encoded string) (bool, error) {
+	decoded, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	return decoded.memory < f.memory || decoded.iterations < f.iterations || decoded.parallelism < f.parallelism ||
+		decoded.keyLength < f.keyLength, nil
+}
+
+func decodeArgon2id(encoded string) (*argon2idHash, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return nil, ErrUnsupportedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, ErrUnsupportedHash
+	}
+	var decoded argon2idHash
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &decoded.memory, &decoded.iterations, &decoded.parallelism); err != nil ||
+		decoded.parallelism == 0 || decoded.iterations == 0 {
+		return nil, ErrUnsupportedHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, ErrUnsupportedHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, ErrUnsupportedHash
+	}
+	decoded.salt, decoded.key = salt, key
+	decoded.saltLength, decoded.keyLength = uint32(len(salt)), uint32(len(key))
+
+	return &decoded, nil
+}
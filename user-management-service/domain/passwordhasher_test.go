@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"user-management-service/config"
+)
+
+var testArgon2idHashing = config.PasswordHashing{
+	Algorithm:         Argon2idAlgorithm,
+	BcryptCost:        bcrypt.DefaultCost,
+	Argon2Memory:      1024,
+	Argon2Iterations:  2,
+	Argon2Parallelism: 1,
+	Argon2SaltLength:  16,
+	Argon2KeyLength:   32,
+}
+
+func TestPasswordHasherVerifiesAllFormats(t *testing.T) {
+	hasher, err := NewPasswordHasher(testArgon2idHashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argon2idHash, err := hasher.Hash([]byte("Str0ng#Secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(argon2idHash, "$argon2id$v=19$m=1024,t=2,p=1$") {
+		t.Fatalf("expected a PHC encoded argon2id hash, got %s", argon2idHash)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Str0ng#Secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, hash := range []string{argon2idHash, string(bcryptHash)} {
+		if matches, err := hasher.Verify(hash, []byte("Str0ng#Secret")); err != nil || !matches {
+			t.Fatalf("expected password to match %s, got %t, %v", hash, matches, err)
+		}
+		if matches, err := hasher.Verify(hash, []byte("wrong password")); err != nil || matches {
+			t.Fatalf("expected wrong password not to match %s, got %t, %v", hash, matches, err)
+		}
+	}
+	if _, err := hasher.Verify("", []byte("Str0ng#Secret")); err != ErrUnsupportedHash {
+		t.Fatalf("expected unsupported hash error for empty hash, got %v", err)
+	}
+}
+
+func TestPasswordHasherNeedsRehash(t *testing.T) {
+	hasher, err := NewPasswordHasher(testArgon2idHashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := hasher.Hash([]byte("Str0ng#Secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	weakerHashing := testArgon2idHashing
+	weakerHashing.Argon2Iterations = 1
+	weakerHasher, err := NewPasswordHasher(weakerHashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	weaker, err := weakerHasher.Hash([]byte("Str0ng#Secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := bcrypt.GenerateFromPassword([]byte("Str0ng#Secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		hash   string
+		rehash bool
+	}{
+		"current parameters": {current, false},
+		"weaker parameters":  {weaker, true},
+		"other algorithm":    {string(legacy), true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if rehash := hasher.NeedsRehash(test.hash); rehash != test.rehash {
+				t.Fatalf("expected rehash %t, got %t", test.rehash, rehash)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherRejectsInvalidArgon2idParameters(t *testing.T) {
+	tests := map[string]func(hashing *config.PasswordHashing){
+		"no parallelism": func(hashing *config.PasswordHashing) { hashing.Argon2Parallelism = 0 },
+		"no iterations":  func(hashing *config.PasswordHashing) { hashing.Argon2Iterations = 0 },
+		"too little memory": func(hashing *config.PasswordHashing) {
+			hashing.Argon2Parallelism = 4
+			hashing.Argon2Memory = 16
+		},
+		"short salt": func(hashing *config.PasswordHashing) { hashing.Argon2SaltLength = 0 },
+		"short key":  func(hashing *config.PasswordHashing) { hashing.Argon2KeyLength = 8 },
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			hashing := testArgon2idHashing
+			modify(&hashing)
+			if _, err := NewPasswordHasher(hashing); err == nil {
+				t.Fatal("expected the parameters to be rejected")
+			}
+		})
+	}
+
+	hasher, err := NewPasswordHasher(testArgon2idHashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if matches, err := hasher.Verify("$argon2id$v=19$m=1024,t=2,p=0$c2FsdHNhbHRzYWx0c2FsdA$a2V5", []byte("Str0ng#Secret")); matches || err == nil {
+		t.Fatal("expected a stored hash without parallelism to be rejected")
+	}
+}
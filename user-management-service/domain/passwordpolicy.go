@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"user-management-service/config"
+)
+
+// maxPasswordBytes is the length limit of bcrypt, longer passwords can't be hashed with it.
+const maxPasswordBytes = 72
+
+// PasswordRule is a single requirement of the password policy. Check returns the violations of the rule,
+// email identifies the user choosing the password.
+type PasswordRule interface {
+	Check(ctx context.Context, email string, password []byte) ([]string, error)
+}
+
+// PasswordHistoryStore returns the previous password hashes of a user.
+type PasswordHistoryStore interface {
+	GetPasswordHistory(ctx context.Context, email string, limit int) ([]string, error)
+}
+
+// PasswordPolicy checks new passwords against all of its rules.
+type PasswordPolicy struct {
+	rules []PasswordRule
+}
+
+// Validate returns the violations of all rules, an empty result means the password is accepted.
+func (p *PasswordPolicy) Validate(ctx context.Context, email string, password []byte) ([]string, error) {
+	violations := make([]string, 0)
+	for _, rule := range p.rules {
+		ruleViolations, err := rule.Check(ctx, email, password)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, ruleViolations...)
+	}
+
+	return violations, nil
+}
+
+// NewPasswordPolicy creates a policy from the given rules.
+func NewPasswordPolicy(rules ...PasswordRule) *PasswordPolicy {
+	return &PasswordPolicy{rules: rules}
+}
+
+// PasswordRules creates the rules enabled by the password policy configuration.
+func PasswordRules(policy config.PasswordPolicy, history PasswordHistoryStore, hasher *PasswordHasher) ([]PasswordRule, error) {
+	rules := []PasswordRule{
+		LengthRule{Min: policy.MinLength, Max: min(policy.MaxLength, maxPasswordBytes)},
+		CharacterClassRule{
+			Uppercase: policy.RequireUppercase,
+			Lowercase: policy.RequireLowercase,
+			Digit:     policy.RequireDigit,
+			Special:   policy.RequireSpecial,
+		},
+	}
+	if policy.HistorySize > 0 {
+		rules = append(rules, HistoryRule{Store: history, Hasher: hasher, Size: policy.HistorySize})
+	}
+	if len(policy.BreachedPasswordsFile) != 0 {
+		breached, err := LoadBreachedPasswords(policy.BreachedPasswordsFile)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, breached)
+	}
+
+	return rules, nil
+}
+
+// LengthRule limits the number of characters of a password, a zero limit is not enforced.
+// Passwords longer than maxPasswordBytes are always rejected, whatever the limits.
+type LengthRule struct {
+	Min int
+	Max int
+}
+
+func (r LengthRule) Check(_ context.Context, _ string, password []byte) ([]string, error) {
+	length := utf8.RuneCount(password)
+	switch {
+	case r.Min > 0 && length < r.Min:
+		return []string{fmt.Sprintf("must be atleast %d characters long", r.Min)}, nil
+	case r.Max > 0 && length > r.Max:
+		return []string{fmt.Sprintf("must be atmost %d characters long", r.Max)}, nil
+	case len(password) > maxPasswordBytes:
+		return []string{fmt.Sprintf("must be atmost %d bytes long", maxPasswordBytes)}, nil
+	}
+
+	return nil, nil
+}
+
+// CharacterClassRule requires a password to contain characters of the enabled classes.
+type CharacterClassRule struct {
+	Uppercase bool
+	Lowercase bool
+	Digit     bool
+	Special   bool
+}
+
+func (r CharacterClassRule) Check(_ context.Context, _ string, password []byte) ([]string, error) {
+	var hasUppercase, hasLowercase, hasDigit, hasSpecial bool
+	for _, char := range string(password) {
+		switch {
+		case unicode.IsUpper(char):
+			hasUppercase = true
+		case unicode.IsLower(char):
+			hasLowercase = true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case !unicode.IsLetter(char):
+			hasSpecial = true
+		}
+	}
+
+	violations := make([]string, 0)
+	if r.Uppercase && !hasUppercase {
+		violations = append(violations, "must contain an uppercase letter")
+	}
+	if r.Lowercase && !hasLowercase {
+		violations = append(violations, "must contain a lowercase letter")
+	}
+	if r.Digit && !hasDigit {
+		violations = append(violations, "must contain a digit")
+	}
+	if r.Special && !hasSpecial {
+		violations = append(violations, "must contain a special character")
+	}
+
+	return violations, nil
+}
+
+// HistoryRule prevents reusing any of the last Size passwords of a user.
+type HistoryRule struct {
+	Store  PasswordHistoryStore
+	Hasher *PasswordHasher
+	Size   int
+}
+
+func (r HistoryRule) Check(ctx context.Context, email string, password []byte) ([]string, error) {
+	hashes, err := r.Store.GetPasswordHistory(ctx, email, r.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hash := range hashes {
+		matches, err := r.Hasher.Verify(hash, password)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			return []string{fmt.Sprintf("must not be one of the last %d passwords", r.Size)}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// BreachedPasswordRule rejects passwords found in an offline list of breached passwords, compared ignoring case.
+type BreachedPasswordRule struct {
+	passwords map[string]struct{}
+}
+
+func (r BreachedPasswordRule) Check(_ context.Context, _ string, password []byte) ([]string, error) {
+	if _, ok := r.passwords[strings.ToLower(string(password))]; ok {
+		return []string{"has appeared in a data breach, please choose a different password"}, nil
+	}
+
+	return nil, nil
+}
+
+// LoadBreachedPasswords reads a breached password list with one password per line, lines starting with # are ignored.
+func LoadBreachedPasswords(path string) (BreachedPasswordRule, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return BreachedPasswordRule{}, err
+	}
+	defer file.Close()
+
+	passwords := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = struct{}{}
+	}
+
+	return BreachedPasswordRule{passwords: passwords}, scanner.Err()
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"user-management-service/config"
+)
+
+type fakePasswordHistory []string
+
+func (f fakePasswordHistory) GetPasswordHistory(_ context.Context, _ string, limit int) ([]string, error) {
+	return f[:min(limit, len(f))], nil
+}
+
+func TestPasswordPolicy(t *testing.T) {
+	breachedFile := filepath.Join(t.TempDir(), "breached-passwords.txt")
+	if err := os.WriteFile(breachedFile, []byte("# breached\nP@ssw0rd123\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	previous, err := bcrypt.GenerateFromPassword([]byte("Previous#Pass1"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hasher, err := NewPasswordHasher(testArgon2idHashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := PasswordRules(config.PasswordPolicy{
+		MinLength:             8,
+		MaxLength:             128,
+		RequireUppercase:      true,
+		RequireLowercase:      true,
+		RequireDigit:          true,
+		RequireSpecial:        true,
+		HistorySize:           5,
+		BreachedPasswordsFile: breachedFile,
+	}, fakePasswordHistory{string(previous)}, hasher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := NewPasswordPolicy(rules...)
+
+	tests := []struct {
+		password   string
+		violations []string
+	}{
+		{"Str0ng#Secret", []string{}},
+		{"Sh0rt#", []string{"must be atleast 8 characters long"}},
+		{"lowercase only", []string{"must contain an uppercase letter", "must contain a digit"}},
+		{"Previous#Pass1", []string{"must not be one of the last 5 passwords"}},
+		{"p@ssw0rd123", []string{"must contain an uppercase letter", "has appeared in a data breach, please choose a different password"}},
+		{"Str0ng#" + strings.Repeat("s", 66), []string{"must be atmost 72 characters long"}},
+		{"Str0ng#" + strings.Repeat("ä", 35), []string{"must be atmost 72 bytes long"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.password, func(t *testing.T) {
+			violations, err := policy.Validate(context.Background(), "user@cisauth.org", []byte(test.password))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Fatalf("expected violations %v, got %v", test.violations, violations)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// ErrReauthenticationFailed when the signed-in user could not confirm their identity before a sensitive account change.
+var ErrReauthenticationFailed = errors.New("re-authentication failed")
+
+// reauthenticationChallenge is the login challenge codes confirming the identity of a signed-in user are sent for.
+const reauthenticationChallenge = "reauthentication"
+
+// Reauthentication confirms the identity of a signed-in user before sensitive account changes, with their password or
+// a code sent to their email for users without a password. Failed attempts count as failed logins of the account and
+// ip address, so a stolen session can't be used to guess the password of its user.
+type Reauthentication struct {
+	hasher     *PasswordHasher
+	throttle   *LoginThrottle
+	loginCodes *LoginCodes
+}
+
+// VerifyPassword checks the current password of the user, wrong passwords are rejected with ErrReauthenticationFailed.
+// It reports whether the failure locked the account.
+func (r *Reauthentication) VerifyPassword(ctx context.Context, user *model.User, password []byte, ipAddress string) (bool, error) {
+	matches, err := r.hasher.Verify(user.Password, password)
+	if err != nil && len(user.Password) != 0 {
+		slog.ErrorContext(ctx, "unable to verify password hash", slog.Any(constants.Error, err))
+	}
+	if !matches {
+		locked, err := r.throttle.Fail(ctx, user.Email, ipAddress)
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to record failed re-authentication", slog.Any(constants.Error, err))
+		}
+		return locked, ErrReauthenticationFailed
+	}
+
+	if err := r.throttle.Reset(ctx, user.Email); err != nil {
+		slog.ErrorContext(ctx, "unable to reset failed logins", slog.Any(constants.Error, err))
+	}
+
+	return false, nil
+}
+
+// IssueCode creates a code confirming the identity of the user which is sent to their email. No code is returned while
+// the previous code is within the resend interval.
+func (r *Reauthentication) IssueCode(ctx context.Context, user *model.User) (string, error) {
+	return r.loginCodes.Issue(ctx, user.Email, reauthenticationCodeChallenge(user.ID))
+}
+
+// WithdrawCode deletes the code of the user after it could not be sent.
+func (r *Reauthentication) WithdrawCode(ctx context.Context, user *model.User) {
+	r.loginCodes.Withdraw(ctx, user.Email, reauthenticationCodeChallenge(user.ID))
+}
+
+// VerifyCode checks the code sent to the user, wrong, expired or used up codes are rejected with
+// ErrReauthenticationFailed.
+func (r *Reauthentication) VerifyCode(ctx context.Context, user *model.User, code, ipAddress string) error {
+	_, err := r.loginCodes.Verify(ctx, user.Email, reauthenticationCodeChallenge(user.ID), code, ipAddress)
+	if errors.Is(err, ErrLoginCodeInvalid) || errors.Is(err, ErrLoginCodeAttempts) {
+		return ErrReauthenticationFailed
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := r.throttle.Reset(ctx, user.Email); err != nil {
+		slog.ErrorContext(ctx, "unable to reset failed logins", slog.Any(constants.Error, err))
+	}
+
+	return nil
+}
+
+// CodeExpiry is how long a sent code can be used.
+func (r *Reauthentication) CodeExpiry() time.Duration {
+	return r.loginCodes.CodeExpiry()
+}
+
+// reauthenticationCodeChallenge binds the codes of a user to their re-authentication, they can't complete a login.
+func reauthenticationCodeChallenge(userID string) string {
+	return strings.Join([]string{reauthenticationChallenge, userID}, ":")
+}
+
+// NewReauthentication creates the re-authentication of signed-in users, failures are counted by the login throttle.
+func NewReauthentication(hasher *PasswordHasher, throttle *LoginThrottle, loginCodes *LoginCodes) *Reauthentication {
+	return &Reauthentication{
+		hasher:     hasher,
+		throttle:   throttle,
+		loginCodes: loginCodes,
+	}
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/config"
+	"user-management-service/model"
+)
+
+func newTestReauthentication(t *testing.T) (*Reauthentication, *LoginThrottle, *PasswordHasher) {
+	t.Helper()
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	throttle := NewLoginThrottle(config.LoginProtection{
+		MaxAccountFailures:   3,
+		MaxIPFailures:        100,
+		FailureWindowSeconds: 900,
+		LockoutSeconds:       60,
+		MaxLockoutSeconds:    900,
+	}, redisClient)
+	hasher, err := NewPasswordHasher(testArgon2idHashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loginCodes := NewLoginCodes(config.EmailOTP{
+		CodeExpirySeconds:     300,
+		MaxAttempts:           3,
+		ResendIntervalSeconds: 30,
+	}, redisClient, throttle)
+
+	return NewReauthentication(hasher, throttle, loginCodes), throttle, hasher
+}
+
+func TestReauthenticationWithPasswordCountsFailedLogins(t *testing.T) {
+	ctx := context.Background()
+	reauthentication, throttle, hasher := newTestReauthentication(t)
+	hash, err := hasher.Hash([]byte("Str0ng#Secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &model.User{ID: "user", Email: "user@cisauth.org", Password: hash}
+
+	if _, err := reauthentication.VerifyPassword(ctx, user, []byte("wrong password"), "10.0.0.1"); !errors.Is(err, ErrReauthenticationFailed) {
+		t.Fatalf("expected a wrong password to be rejected, got %v", err)
+	}
+	if _, err := reauthentication.VerifyPassword(ctx, user, []byte("Str0ng#Secret"), "10.0.0.1"); err != nil {
+		t.Fatalf("expected the password to be verified, got %v", err)
+	}
+
+	// the verified password reset the failures, the lockout is only reached by three failures in a row.
+	for i := 0; i < 3; i++ {
+		locked, err := reauthentication.VerifyPassword(ctx, user, []byte("wrong password"), "10.0.0.1")
+		if !errors.Is(err, ErrReauthenticationFailed) {
+			t.Fatalf("attempt %d: expected a wrong password to be rejected, got %v", i+1, err)
+		}
+		if locked != (i == 2) {
+			t.Fatalf("attempt %d: expected the account to be reported locked only when the limit is reached", i+1)
+		}
+	}
+	lockout, err := throttle.Lockout(ctx, user.Email, "10.0.0.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lockout == 0 {
+		t.Fatal("expected the account to be locked out after repeated wrong passwords")
+	}
+}
+
+func TestReauthenticationWithPasswordRejectsUsersWithoutPassword(t *testing.T) {
+	reauthentication, _, _ := newTestReauthentication(t)
+	user := &model.User{ID: "user", Email: "federated@cisauth.org"}
+
+	if _, err := reauthentication.VerifyPassword(context.Background(), user, []byte(""), "10.0.0.1"); !errors.Is(err, ErrReauthenticationFailed) {
+		t.Fatalf("expected users without a password to be rejected, got %v", err)
+	}
+}
+
+func TestReauthenticationWithCodeConfirmsUsersWithoutPassword(t *testing.T) {
+	ctx := context.Background()
+	reauthentication, _, _ := newTestReauthentication(t)
+	user := &model.User{ID: "user", Email: "federated@cisauth.org"}
+
+	code, err := reauthentication.IssueCode(ctx, user)
+	if err != nil || len(code) == 0 {
+		t.Fatalf("expected a code to be issued, got %v", err)
+	}
+	if _, err := reauthentication.loginCodes.Verify(ctx, user.Email, "user", code, "10.0.0.1"); !errors.Is(err, ErrLoginCodeInvalid) {
+		t.Fatalf("expected the code not to complete a login, got %v", err)
+	}
+	if err := reauthentication.VerifyCode(ctx, &model.User{ID: "other", Email: user.Email}, code, "10.0.0.1"); !errors.Is(err, ErrReauthenticationFailed) {
+		t.Fatalf("expected the code not to confirm another user, got %v", err)
+	}
+	if err := reauthentication.VerifyCode(ctx, user, "000000x", "10.0.0.1"); !errors.Is(err, ErrReauthenticationFailed) {
+		t.Fatalf("expected a wrong code to be rejected, got %v", err)
+	}
+	if err := reauthentication.VerifyCode(ctx, user, code, "10.0.0.1"); err != nil {
+		t.Fatalf("expected the code to be verified, got %v", err)
+	}
+	if err := reauthentication.VerifyCode(ctx, user, code, "10.0.0.1"); !errors.Is(err, ErrReauthenticationFailed) {
+		t.Fatalf("expected a verified code to be used up, got %v", err)
+	}
+}
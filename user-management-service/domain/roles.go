@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"user-management-service/model"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// DefaultRole is assigned to every user on registration.
+	DefaultRole = "user"
+	// AdminRole manages users and their roles.
+	AdminRole = "admin"
+)
+
+const (
+	// UsersReadPermission reads any user and the roles of users.
+	UsersReadPermission = "users:read"
+	// UsersWritePermission manages any user.
+	UsersWritePermission = "users:write"
+	// RolesWritePermission assigns roles to users.
+	RolesWritePermission = "roles:write"
+	// ClientsWritePermission manages oauth2 clients.
+	ClientsWritePermission = "clients:write"
+)
+
+// ErrRoleNotFound when the role to assign does not exist.
+var ErrRoleNotFound = errors.New("role not found")
+
+func (s *service) ListRoles(ctx context.Context) ([]model.Role, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT r."name", r."description", COALESCE(array_agg(p."name" ORDER BY p."name") FILTER (WHERE p."name" IS NOT NULL), '{}')
+		FROM roles r
+		LEFT JOIN role_permissions rp ON rp."roleID" = r."ID"
+		LEFT JOIN permissions p ON p."ID" = rp."permissionID"
+		GROUP BY r."name", r."description"
+		ORDER BY r."name"`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]model.Role, 0)
+	for rows.Next() {
+		var role model.Role
+		if err := rows.Scan(&role.Name, &role.Description, pq.Array(&role.Permissions)); err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+func (s *service) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT r."name" FROM user_roles ur
+		JOIN roles r ON r."ID" = ur."roleID"
+		WHERE ur."userID" = $1
+		ORDER BY r."name"`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]string, 0)
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+func (s *service) GetUserPermissions(ctx context.Context, userID string) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT DISTINCT p."name" FROM user_roles ur
+		JOIN role_permissions rp ON rp."roleID" = ur."roleID"
+		JOIN permissions p ON p."ID" = rp."permissionID"
+		WHERE ur."userID" = $1
+		ORDER BY p."name"`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	permissions := make([]string, 0)
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
+
+func (s *service) AssignRole(ctx context.Context, userID, role string) error {
+	result, err := s.db.ExecContext(ctx, `INSERT INTO user_roles("userID", "roleID")
+		SELECT u."ID", r."ID" FROM users u, roles r WHERE u."ID" = $1 AND r."name" = $2
+		ON CONFLICT DO NOTHING`, userID, role)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected != 0 {
+		return nil
+	}
+
+	// nothing inserted, either the role was already assigned or the user or role does not exist.
+	return s.roleAssignmentExists(ctx, userID, role)
+}
+
+func (s *service) RemoveRole(ctx context.Context, userID, role string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM user_roles ur USING roles r
+		WHERE ur."roleID" = r."ID" AND ur."userID" = $1 AND r."name" = $2`, userID, role)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (s *service) roleAssignmentExists(ctx context.Context, userID, role string) error {
+	var userExists, roleExists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM users WHERE "ID" = $1), EXISTS(SELECT 1 FROM roles WHERE "name" = $2)`, userID, role).
+		Scan(&userExists, &roleExists); err != nil {
+		return err
+	}
+	if !userExists {
+		return sql.ErrNoRows
+	}
+	if !roleExists {
+		return ErrRoleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package grpcserver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+
+	"user-management-service/domain"
+	"user-management-service/model"
+)
+
+// GRPCHandler implements pb.UserManagementServer gRPC interface.
+type GRPCHandler struct {
+	pb.UnimplementedUserManagementServer
+	userService domain.Service
+}
+
+// GetEmail returns the email address of the user with the given id.
+func (h *GRPCHandler) GetEmail(ctx context.Context, request *pb.EmailRequest) (*pb.Email, error) {
+	user, err := h.getUser(ctx, request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Email{Id: user.Email}, nil
+}
+
+// GetUser returns the user with the given id, soft-deleted users are not found like in GetUsers.
+func (h *GRPCHandler) GetUser(ctx context.Context, request *pb.GetUserRequest) (*pb.User, error) {
+	user, err := h.getUser(ctx, request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return toUser(user), nil
+}
+
+// GetUserByEmail returns the user with the given email address.
+func (h *GRPCHandler) GetUserByEmail(ctx context.Context, request *pb.GetUserByEmailRequest) (*pb.User, error) {
+	if len(request.GetEmail()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	user, err := h.userService.GetUserByEmail(ctx, request.GetEmail())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return toUser(user), nil
+}
+
+// GetUsers returns the users for the given ids, unknown ids are skipped.
+func (h *GRPCHandler) GetUsers(ctx context.Context, request *pb.GetUsersRequest) (*pb.GetUsersResponse, error) {
+	if len(request.GetUserIDs()) == 0 {
+		return &pb.GetUsersResponse{}, nil
+	}
+
+	for _, userID := range request.GetUserIDs() {
+		if err := uuid.Validate(userID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "userID %q is invalid", userID)
+		}
+	}
+
+	users, err := h.userService.GetUsers(ctx, request.GetUserIDs())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	response := &pb.GetUsersResponse{Users: make([]*pb.User, 0, len(users))}
+	for i := range users {
+		response.Users = append(response.Users, toUser(&users[i]))
+	}
+
+	return response, nil
+}
+
+func (h *GRPCHandler) getUser(ctx context.Context, userID string) (*model.User, error) {
+	if err := uuid.Validate(userID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "userID is invalid")
+	}
+
+	user, err := h.userService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	if user.DeletedAt != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	return user, nil
+}
+
+func toUser(user *model.User) *pb.User {
+	return &pb.User{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		CreatedAt: user.CreatedAt.UTC().Format(http.TimeFormat),
+	}
+}
+
+func toStatus(ctx context.Context, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "user not found")
+	}
+	if errors.Is(err, domain.ErrUserLocked) {
+		return status.Error(codes.PermissionDenied, "user is locked")
+	}
+
+	slog.ErrorContext(ctx, "unable to fetch user", slog.Any(constants.Error, err))
+	return status.Error(codes.Internal, "unable to fetch user")
+}
+
+// NewGRPCHandler creates an object of GRPCHandler.
+func NewGRPCHandler(userService domain.Service) *GRPCHandler {
+	return &GRPCHandler{
+		userService: userService,
+	}
+}
@@ -0,0 +1,42 @@
+package grpcserver
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+)
+
+// GRPCServer implements gRPC server implementation for user service.
+type GRPCServer struct {
+	server *grpc.Server
+	port   string
+}
+
+// NewGRPCServer is a constructor and returns a pointer to GRPCServer object.
+func NewGRPCServer(port string, userManagementServer pb.UserManagementServer) *GRPCServer {
+	server := grpc.NewServer()
+	pb.RegisterUserManagementServer(server, userManagementServer)
+
+	return &GRPCServer{
+		server: server,
+		port:   port,
+	}
+}
+
+// ListenAndServe spin up the server on a given port.
+func (s *GRPCServer) ListenAndServe() error {
+	lis, err := net.Listen("tcp", s.port)
+	if err != nil {
+		return err
+	}
+
+	return s.server.Serve(lis)
+}
+
+// Stop stops the grpc server once the pending requests are finished, the server can be stopped before it was
+// started.
+func (s *GRPCServer) Stop() {
+	s.server.GracefulStop()
+}
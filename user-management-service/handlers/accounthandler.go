@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"user-management-service/apperror"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// ExportAccount returns the personal data held about the signed-in user as a downloadable JSON document,
+// their profile, sessions, linked identities and the consents granted to oauth2 clients.
+func (h *Handler) ExportAccount(c *gin.Context) {
+	ctx := c.Request.Context()
+	session := c.MustGet(constants.SessionContext).(models.Session)
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+	userID := userProfile.ID.String()
+
+	user, err := h.userService.GetUser(ctx, userID)
+	if err != nil {
+		h.abortWithUserError(c, err, "unable to fetch user for export")
+		return
+	}
+
+	identities, err := h.userService.GetIdentities(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch identities for export", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to export account",
+		})
+		return
+	}
+
+	sessions, err := h.tmsClient.ListSessions(ctx, &pb.ListSessionsRequest{
+		UserID:             userID,
+		CurrentSessionID:   session.ID,
+		CurrentAccessToken: session.AccessToken,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list sessions for export", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to export account",
+		})
+		return
+	}
+
+	consents, err := h.tmsClient.ListConsentSessions(ctx, &pb.ListConsentSessionsRequest{Subject: userID})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list consent sessions for export", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to export account",
+		})
+		return
+	}
+
+	export := model.AccountExport{
+		Profile: model.AccountProfile{
+			ID:        user.ID,
+			Email:     user.Email,
+			Name:      user.Name,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		},
+		Sessions:   toSessions(sessions.Sessions),
+		Identities: make([]model.LinkedIdentity, 0, len(identities)),
+		Consents:   make([]model.ConsentGrant, 0, len(consents.Sessions)),
+		ExportedAt: time.Now().UTC(),
+	}
+	for _, identity := range identities {
+		export.Identities = append(export.Identities, model.LinkedIdentity{
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			Email:    identity.Email,
+			LinkedAt: identity.CreatedAt,
+		})
+	}
+	for _, consent := range consents.Sessions {
+		export.Consents = append(export.Consents, model.ConsentGrant{
+			ClientID:   consent.ClientID,
+			ClientName: consent.ClientName,
+			Scopes:     consent.GrantedScopes,
+			Audience:   consent.GrantedAudience,
+			GrantedAt:  time.Unix(consent.GrantedAt, 0).UTC(),
+			Remember:   consent.Remember,
+		})
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="account-export.json"`)
+	c.JSON(http.StatusOK, export)
+}
+
+// DeleteAccount deletes the signed-in user after confirming their password or a code sent to their email. All tokens,
+// login and consent sessions are revoked right away, the account is purged once the deletion grace period has passed.
+func (h *Handler) DeleteAccount(c *gin.Context) {
+	request := model.DeleteAccount{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	user, err := h.userService.GetUser(ctx, userProfile.ID.String())
+	if err != nil {
+		h.abortWithUserError(c, err, "unable to fetch user for deletion")
+		return
+	}
+
+	if !h.reauthenticate(c, user, request.Password, request.Code) {
+		return
+	}
+
+	h.revokeUserSessions(ctx, user.ID)
+
+	if err := h.userService.SoftDeleteUser(ctx, user.ID); err != nil {
+		h.abortWithUserError(c, err, "unable to delete user")
+		return
+	}
+	slog.InfoContext(ctx, "user deleted their account")
+
+	clearAuthCookies(c)
+
+	c.Status(http.StatusNoContent)
+}
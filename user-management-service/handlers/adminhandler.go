@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"user-management-service/apperror"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// ListUsers returns a page of users, optionally filtered by email or name.
+func (h *Handler) ListUsers(c *gin.Context) {
+	filter := model.UserFilter{}
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	users, total, err := h.userService.ListUsers(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list users", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to list users",
+		})
+		return
+	}
+
+	page := model.UserPage{
+		Users:    make([]model.AdminUser, 0, len(users)),
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+		Total:    total,
+	}
+	for i := range users {
+		page.Users = append(page.Users, toAdminUser(&users[i]))
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetUser returns a single user, including soft-deleted users.
+func (h *Handler) GetUser(c *gin.Context) {
+	request := model.UserRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	user, err := h.userService.GetUser(ctx, request.UserID)
+	if err != nil {
+		h.abortWithUserError(c, err, "unable to fetch user")
+		return
+	}
+
+	c.JSON(http.StatusOK, toAdminUser(user))
+}
+
+// DeleteUser soft-deletes a user and signs them out everywhere.
+func (h *Handler) DeleteUser(c *gin.Context) {
+	h.updateUserState(c, h.userService.SoftDeleteUser, true, "unable to delete user")
+}
+
+// RestoreUser restores a soft-deleted user.
+func (h *Handler) RestoreUser(c *gin.Context) {
+	h.updateUserState(c, h.userService.RestoreUser, false, "unable to restore user")
+}
+
+// LockUser locks the account of a user and signs them out everywhere.
+func (h *Handler) LockUser(c *gin.Context) {
+	h.updateUserState(c, h.userService.LockUser, true, "unable to lock user")
+}
+
+// UnlockUser unlocks the account of a user.
+func (h *Handler) UnlockUser(c *gin.Context) {
+	h.updateUserState(c, h.userService.UnlockUser, false, "unable to unlock user")
+}
+
+// ForcePasswordReset blocks login of a user until they reset their password,
+// signs them out everywhere and sends them a password reset email.
+func (h *Handler) ForcePasswordReset(c *gin.Context) {
+	request := model.UserRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	if h.isCaller(c, request.UserID) {
+		return
+	}
+	ctx := c.Request.Context()
+
+	user, err := h.userService.GetUser(ctx, request.UserID)
+	if err != nil {
+		h.abortWithUserError(c, err, "unable to force password reset")
+		return
+	}
+
+	if err := h.userService.RequirePasswordReset(ctx, request.UserID); err != nil {
+		h.abortWithUserError(c, err, "unable to force password reset")
+		return
+	}
+
+	h.revokeUserSessions(ctx, request.UserID)
+
+	if err := h.sendPasswordReset(ctx, user.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "password reset is required but the reset email could not be sent",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// updateUserState applies an account state change to the user of the request.
+func (h *Handler) updateUserState(c *gin.Context, update func(ctx context.Context, userID string) error, revokeSessions bool, message string) {
+	request := model.UserRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	if h.isCaller(c, request.UserID) {
+		return
+	}
+	ctx := c.Request.Context()
+
+	if err := update(ctx, request.UserID); err != nil {
+		h.abortWithUserError(c, err, message)
+		return
+	}
+
+	if revokeSessions {
+		h.revokeUserSessions(ctx, request.UserID)
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// isCaller reports whether the user is the authenticated admin and rejects the request then, so admins cannot lock
+// themselves out or remove their own access.
+func (h *Handler) isCaller(c *gin.Context, userID string) bool {
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+	if !strings.EqualFold(userProfile.ID.String(), userID) {
+		return false
+	}
+
+	slog.ErrorContext(c.Request.Context(), "admin tried to change their own account")
+	c.JSON(http.StatusForbidden, gin.H{
+		"message": "admins cannot change their own account",
+	})
+	return true
+}
+
+// revokeUserSessions revokes the token sessions and oauth2 login sessions of a user.
+func (h *Handler) revokeUserSessions(ctx context.Context, userID string) {
+	if _, err := h.tmsClient.RevokeAllSessions(ctx, &pb.RevokeAllSessionsRequest{UserID: userID}); err != nil {
+		slog.ErrorContext(ctx, "unable to revoke sessions of user", slog.Any(constants.Error, err))
+	}
+	if _, err := h.tmsClient.RevokeLoginSessions(ctx, &pb.RevokeLoginSessionsRequest{Subject: userID}); err != nil {
+		slog.ErrorContext(ctx, "unable to revoke login sessions of user", slog.Any(constants.Error, err))
+	}
+}
+
+func (h *Handler) abortWithUserError(c *gin.Context, err error, message string) {
+	slog.ErrorContext(c.Request.Context(), message, slog.Any(constants.Error, err))
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": "user not found or already in the requested state",
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"message": message,
+	})
+}
+
+func toAdminUser(user *model.User) model.AdminUser {
+	return model.AdminUser{
+		ID:                    user.ID,
+		Email:                 user.Email,
+		Name:                  user.Name,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
+		DeletedAt:             user.DeletedAt,
+		LockedAt:              user.LockedAt,
+		PasswordResetRequired: user.PasswordResetRequired,
+	}
+}
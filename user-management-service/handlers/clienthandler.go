@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"user-management-service/apperror"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// CreateClient creates an oauth2 client, its generated secret is only returned by this call.
+func (h *Handler) CreateClient(c *gin.Context) {
+	request := model.ClientMetadata{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	client, err := h.tmsClient.CreateClient(c.Request.Context(), &pb.CreateClientRequest{Metadata: toClientMetadata(request)})
+	if err != nil {
+		h.abortWithClientError(c, err, "unable to create client")
+		return
+	}
+	slog.InfoContext(c.Request.Context(), "admin created oauth2 client", slog.String("clientID", client.ClientID))
+
+	c.JSON(http.StatusCreated, toClient(client))
+}
+
+// UpdateClient replaces the metadata of an oauth2 client, its secret is kept.
+func (h *Handler) UpdateClient(c *gin.Context) {
+	clientRequest := model.ClientRequest{}
+	if err := c.ShouldBindUri(&clientRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	request := model.ClientMetadata{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	client, err := h.tmsClient.UpdateClient(c.Request.Context(), &pb.UpdateClientRequest{
+		ClientID: clientRequest.ClientID,
+		Metadata: toClientMetadata(request),
+	})
+	if err != nil {
+		h.abortWithClientError(c, err, "unable to update client")
+		return
+	}
+	slog.InfoContext(c.Request.Context(), "admin updated oauth2 client", slog.String("clientID", client.ClientID))
+
+	c.JSON(http.StatusOK, toClient(client))
+}
+
+// RotateClientSecret replaces the secret of an oauth2 client, the old secret stops working right away.
+func (h *Handler) RotateClientSecret(c *gin.Context) {
+	request := model.ClientRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	client, err := h.tmsClient.RotateClientSecret(c.Request.Context(), &pb.RotateClientSecretRequest{ClientID: request.ClientID})
+	if err != nil {
+		h.abortWithClientError(c, err, "unable to rotate client secret")
+		return
+	}
+	slog.InfoContext(c.Request.Context(), "admin rotated oauth2 client secret", slog.String("clientID", client.ClientID))
+
+	c.JSON(http.StatusOK, toClient(client))
+}
+
+// DeleteClient deletes an oauth2 client together with its tokens.
+func (h *Handler) DeleteClient(c *gin.Context) {
+	request := model.ClientRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	if _, err := h.tmsClient.DeleteClient(c.Request.Context(), &pb.DeleteClientRequest{ClientID: request.ClientID}); err != nil {
+		h.abortWithClientError(c, err, "unable to delete client")
+		return
+	}
+	slog.InfoContext(c.Request.Context(), "admin deleted oauth2 client", slog.String("clientID", request.ClientID))
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) abortWithClientError(c *gin.Context, err error, message string) {
+	slog.ErrorContext(c.Request.Context(), message, slog.Any(constants.Error, err))
+	switch status.Code(err) {
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": "client not found",
+		})
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "client metadata was rejected by the oauth2 server",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": message,
+		})
+	}
+}
+
+func toClientMetadata(metadata model.ClientMetadata) *pb.ClientMetadata {
+	return &pb.ClientMetadata{
+		ClientName:                metadata.ClientName,
+		RedirectURIs:              metadata.RedirectURIs,
+		GrantTypes:                metadata.GrantTypes,
+		ResponseTypes:             metadata.ResponseTypes,
+		Scope:                     metadata.Scope,
+		Audience:                  metadata.Audience,
+		TokenEndpointAuthMethod:   metadata.TokenEndpointAuthMethod,
+		LogoURI:                   metadata.LogoURI,
+		Trusted:                   metadata.Trusted,
+		LoginRememberForSeconds:   metadata.LoginRememberForSeconds,
+		ConsentRememberForSeconds: metadata.ConsentRememberForSeconds,
+	}
+}
+
+func toClient(client *pb.ClientResponse) model.Client {
+	metadata := client.GetMetadata()
+	return model.Client{
+		ClientID:     client.ClientID,
+		ClientSecret: client.ClientSecret,
+		ClientMetadata: model.ClientMetadata{
+			ClientName:                metadata.GetClientName(),
+			RedirectURIs:              metadata.GetRedirectURIs(),
+			GrantTypes:                metadata.GetGrantTypes(),
+			ResponseTypes:             metadata.GetResponseTypes(),
+			Scope:                     metadata.GetScope(),
+			Audience:                  metadata.GetAudience(),
+			TokenEndpointAuthMethod:   metadata.GetTokenEndpointAuthMethod(),
+			LogoURI:                   metadata.GetLogoURI(),
+			Trusted:                   metadata.GetTrusted(),
+			LoginRememberForSeconds:   metadata.GetLoginRememberForSeconds(),
+			ConsentRememberForSeconds: metadata.GetConsentRememberForSeconds(),
+		},
+	}
+}
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"user-management-service/apperror"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+const (
+	errInvalidClientMetadata = "invalid_client_metadata"
+	errInvalidRedirectURI    = "invalid_redirect_uri"
+	errInvalidToken          = "invalid_token"
+)
+
+// IssueInitialAccessToken issues a single use token an admin hands to a partner to register their client with.
+func (h *Handler) IssueInitialAccessToken(c *gin.Context) {
+	request := model.InitialAccessTokenRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	token, err := h.tmsClient.IssueInitialAccessToken(ctx, &pb.IssueInitialAccessTokenRequest{ExpiresInSeconds: request.ExpiresInSeconds})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to issue initial access token", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to issue initial access token",
+		})
+		return
+	}
+	slog.InfoContext(ctx, "admin issued initial access token")
+
+	c.JSON(http.StatusCreated, model.InitialAccessToken{
+		Token:     token.Token,
+		ExpiresAt: time.Unix(token.ExpiresAt, 0).UTC(),
+	})
+}
+
+// RegisterClient registers a partner client with an initial access token (RFC 7591). The response carries the
+// client secret and the registration access token the client is managed with afterwards.
+func (h *Handler) RegisterClient(c *gin.Context) {
+	initialAccessToken, ok := bearerToken(c)
+	if !ok {
+		return
+	}
+	request := model.ClientRegistrationMetadata{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":             errInvalidClientMetadata,
+			"error_description": "client metadata is not valid json",
+		})
+		return
+	}
+
+	client, err := h.tmsClient.RegisterClient(c.Request.Context(), &pb.RegisterClientRequest{
+		InitialAccessToken: initialAccessToken,
+		Metadata:           toRegistrationMetadata(request),
+	})
+	if err != nil {
+		h.abortWithRegistrationError(c, err, "unable to register client")
+		return
+	}
+	slog.InfoContext(c.Request.Context(), "registered partner client", slog.String("clientID", client.ClientID))
+
+	c.JSON(http.StatusCreated, h.toClientRegistrationResponse(client))
+}
+
+// RegisteredClient returns the registration of a partner client to the holder of its registration access token (RFC 7592).
+func (h *Handler) RegisteredClient(c *gin.Context) {
+	request, registrationAccessToken, ok := bindRegisteredClient(c)
+	if !ok {
+		return
+	}
+
+	client, err := h.tmsClient.GetRegisteredClient(c.Request.Context(), &pb.RegisteredClientRequest{
+		ClientID:                request.ClientID,
+		RegistrationAccessToken: registrationAccessToken,
+	})
+	if err != nil {
+		h.abortWithRegistrationError(c, err, "unable to fetch registered client")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.toClientRegistrationResponse(client))
+}
+
+// UpdateRegisteredClient replaces the metadata of a partner client (RFC 7592).
+func (h *Handler) UpdateRegisteredClient(c *gin.Context) {
+	request, registrationAccessToken, ok := bindRegisteredClient(c)
+	if !ok {
+		return
+	}
+	metadata := model.ClientRegistrationMetadata{}
+	if err := c.ShouldBindJSON(&metadata); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":             errInvalidClientMetadata,
+			"error_description": "client metadata is not valid json",
+		})
+		return
+	}
+
+	client, err := h.tmsClient.UpdateRegisteredClient(c.Request.Context(), &pb.UpdateRegisteredClientRequest{
+		ClientID:                request.ClientID,
+		RegistrationAccessToken: registrationAccessToken,
+		Metadata:                toRegistrationMetadata(metadata),
+	})
+	if err != nil {
+		h.abortWithRegistrationError(c, err, "unable to update registered client")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.toClientRegistrationResponse(client))
+}
+
+// DeleteRegisteredClient deletes a partner client together with its tokens (RFC 7592).
+func (h *Handler) DeleteRegisteredClient(c *gin.Context) {
+	request, registrationAccessToken, ok := bindRegisteredClient(c)
+	if !ok {
+		return
+	}
+
+	if _, err := h.tmsClient.DeleteRegisteredClient(c.Request.Context(), &pb.RegisteredClientRequest{
+		ClientID:                request.ClientID,
+		RegistrationAccessToken: registrationAccessToken,
+	}); err != nil {
+		h.abortWithRegistrationError(c, err, "unable to delete registered client")
+		return
+	}
+	slog.InfoContext(c.Request.Context(), "deleted partner client", slog.String("clientID", request.ClientID))
+
+	c.Status(http.StatusNoContent)
+}
+
+func bindRegisteredClient(c *gin.Context) (model.RegisteredClientRequest, string, bool) {
+	request := model.RegisteredClientRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return request, "", false
+	}
+	registrationAccessToken, ok := bearerToken(c)
+
+	return request, registrationAccessToken, ok
+}
+
+// bearerToken returns the bearer token of the authorization header and answers requests without one.
+func bearerToken(c *gin.Context) (string, bool) {
+	scheme, token, found := strings.Cut(c.GetHeader(constants.Authorization), " ")
+	if !found || scheme != constants.Bearer || len(token) == 0 {
+		c.Header("WWW-Authenticate", constants.Bearer)
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":             errInvalidToken,
+			"error_description": "bearer token is missing",
+		})
+		return "", false
+	}
+
+	return token, true
+}
+
+// abortWithRegistrationError answers with the error codes of RFC 7591 and RFC 6750.
+func (h *Handler) abortWithRegistrationError(c *gin.Context, err error, message string) {
+	slog.ErrorContext(c.Request.Context(), message, slog.Any(constants.Error, err))
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		code := errInvalidClientMetadata
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == constants.InvalidRedirectURI {
+				code = errInvalidRedirectURI
+			}
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":             code,
+			"error_description": st.Message(),
+		})
+	case codes.Unauthenticated:
+		c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":             errInvalidToken,
+			"error_description": "token is invalid, expired or was already used",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":             "server_error",
+			"error_description": message,
+		})
+	}
+}
+
+func toRegistrationMetadata(metadata model.ClientRegistrationMetadata) *pb.ClientMetadata {
+	return &pb.ClientMetadata{
+		ClientName:              metadata.ClientName,
+		RedirectURIs:            metadata.RedirectURIs,
+		GrantTypes:              metadata.GrantTypes,
+		ResponseTypes:           metadata.ResponseTypes,
+		Scope:                   strings.Fields(metadata.Scope),
+		TokenEndpointAuthMethod: metadata.TokenEndpointAuthMethod,
+		LogoURI:                 metadata.LogoURI,
+	}
+}
+
+func (h *Handler) toClientRegistrationResponse(client *pb.RegisteredClientResponse) model.ClientRegistrationResponse {
+	metadata := client.GetMetadata()
+	return model.ClientRegistrationResponse{
+		ClientID:                client.ClientID,
+		ClientSecret:            client.ClientSecret,
+		ClientIDIssuedAt:        client.ClientIDIssuedAt,
+		RegistrationAccessToken: client.RegistrationAccessToken,
+		RegistrationClientURI:   strings.Join([]string{h.serviceConfig.ClientRegistration.RegistrationURL, client.ClientID}, "/"),
+		ClientRegistrationMetadata: model.ClientRegistrationMetadata{
+			RedirectURIs:            metadata.GetRedirectURIs(),
+			GrantTypes:              metadata.GetGrantTypes(),
+			ResponseTypes:           metadata.GetResponseTypes(),
+			ClientName:              metadata.GetClientName(),
+			Scope:                   strings.Join(metadata.GetScope(), " "),
+			TokenEndpointAuthMethod: metadata.GetTokenEndpointAuthMethod(),
+			LogoURI:                 metadata.GetLogoURI(),
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"user-management-service/apperror"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// ConsentChallenge returns the consent screen of a login consent. Consents remembered by the user and consents
+// of trusted first-party clients are accepted without prompting, the response then only has the redirect.
+func (h *Handler) ConsentChallenge(c *gin.Context) {
+	consentRequest := model.ConsentRequest{}
+	if err := c.ShouldBindQuery(&consentRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	consent, err := h.tmsClient.GetConsent(ctx, &pb.GetConsentRequest{ConsentChallenge: consentRequest.ConsentChallenge})
+	if err != nil {
+		h.abortWithConsentError(c, err, "unable to fetch consent")
+		return
+	}
+
+	if consent.Skip || consent.Trusted {
+		slog.InfoContext(ctx, "accepting consent without prompt", slog.Bool("skip", consent.Skip), slog.Bool("trusted", consent.Trusted))
+		h.acceptConsent(c, &pb.AcceptConsentRequest{
+			ConsentChallenge: consentRequest.ConsentChallenge,
+			GrantScope:       consent.RequestedScope,
+			GrantAudience:    consent.RequestedAudience,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ConsentPrompt{
+		ConsentChallenge: consentRequest.ConsentChallenge,
+		Client: model.OAuth2Client{
+			ID:      consent.ClientID,
+			Name:    consent.ClientName,
+			LogoURI: consent.LogoURI,
+		},
+		RequestedScope:    consent.RequestedScope,
+		RequestedAudience: consent.RequestedAudience,
+	})
+}
+
+// AcceptConsent grants the client the scope and audience chosen by the user on the consent screen.
+func (h *Handler) AcceptConsent(c *gin.Context) {
+	request := model.ConsentDecision{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	h.acceptConsent(c, &pb.AcceptConsentRequest{
+		ConsentChallenge: request.ConsentChallenge,
+		GrantScope:       request.GrantScope,
+		GrantAudience:    request.GrantAudience,
+		Remember:         request.Remember,
+	})
+}
+
+// RejectConsent denies the client access, the client receives an access_denied error.
+func (h *Handler) RejectConsent(c *gin.Context) {
+	request := model.ConsentRejection{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	consent, err := h.tmsClient.RejectConsent(c.Request.Context(), &pb.RejectConsentRequest{ConsentChallenge: request.ConsentChallenge})
+	if err != nil {
+		h.abortWithConsentError(c, err, "unable to reject consent")
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AcceptLogin{RedirectTo: consent.RedirectTo})
+}
+
+func (h *Handler) acceptConsent(c *gin.Context, request *pb.AcceptConsentRequest) {
+	consent, err := h.tmsClient.AcceptConsent(c.Request.Context(), request)
+	if err != nil {
+		h.abortWithConsentError(c, err, "unable to accept consent")
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AcceptLogin{RedirectTo: consent.RedirectTo})
+}
+
+func (h *Handler) abortWithConsentError(c *gin.Context, err error, message string) {
+	slog.ErrorContext(c.Request.Context(), message, slog.Any(constants.Error, err))
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "granted scope or audience was not requested",
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "consent challenge is invalid or expired",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": message,
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"user-management-service/apperror"
+	"user-management-service/domain"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// BeginFederatedLogin returns the authorization url of the upstream identity provider for the oauth2 login challenge.
+func (h *Handler) BeginFederatedLogin(c *gin.Context) {
+	provider := model.FederatedLoginRequest{}
+	if err := c.ShouldBindUri(&provider); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	request := model.FederatedLogin{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	authURL, err := h.federation.Begin(c.Request.Context(), provider.Provider, request.LoginChallenge, request.Remember)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnknownProvider) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": "identity provider not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AcceptLogin{RedirectTo: authURL})
+}
+
+// FinishFederatedLogin completes a federated login with the authorization response of the upstream identity provider
+// and continues the login of the linked user like any other first factor.
+func (h *Handler) FinishFederatedLogin(c *gin.Context) {
+	request := model.FederatedCallback{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	authentication, err := h.federation.Finish(ctx, request.State, request.Code)
+	if err == nil && authentication.User.LockedAt != nil {
+		err = domain.ErrUserLocked
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrFederationStateExpired):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "login expired, please login again",
+			})
+		case errors.Is(err, domain.ErrUpstreamLogin):
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "unable to verify login with identity provider",
+			})
+		case errors.Is(err, domain.ErrUnverifiedEmail):
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "identity provider did not share a verified email",
+			})
+		case errors.Is(err, domain.ErrLinkConfirmationRequired):
+			c.JSON(http.StatusConflict, gin.H{
+				"message": "an account with this email already exists, login with it to link the identity provider",
+			})
+		case errors.Is(err, domain.ErrEmailUnavailable):
+			c.JSON(http.StatusConflict, gin.H{
+				"message": "email of identity provider is not available",
+			})
+		case errors.Is(err, domain.ErrUserLocked):
+			slog.ErrorContext(ctx, "login attempted on locked account")
+			c.JSON(http.StatusForbidden, gin.H{
+				"message": "account is locked, please contact support",
+			})
+		default:
+			slog.ErrorContext(ctx, "unable to complete federated login", slog.Any(constants.Error, err))
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to login",
+			})
+		}
+		return
+	}
+
+	h.loginWithFirstFactor(c, authentication.User, authentication.LoginChallenge, constants.AMRFederated, authentication.Remember)
+}
@@ -27,16 +27,23 @@ import (
 	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
 	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
 	"github.com/redis/go-redis/v9"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type Handler struct {
-	kmsClient     *kms.Client
-	tmsClient     pb.TokenServiceClient
-	serviceConfig *config.ServiceConfig
-	redisClient   *redis.Client
-	emailQueue    rmq.Queue
-	userService   domain.Service
+	kmsClient        *kms.Client
+	tmsClient        pb.TokenServiceClient
+	serviceConfig    *config.ServiceConfig
+	redisClient      *redis.Client
+	emailQueue       rmq.Queue
+	userService      domain.Service
+	passkeys         *domain.Passkeys
+	federation       *domain.Federation
+	loginThrottle    *domain.LoginThrottle
+	loginCodes       *domain.LoginCodes
+	mfaLogins        *domain.MFALogins
+	reauthentication *domain.Reauthentication
+	passwordPolicy   *domain.PasswordPolicy
+	passwordHasher   *domain.PasswordHasher
 }
 
 func NewHandler(kmsClient *kms.Client,
@@ -44,14 +51,28 @@ func NewHandler(kmsClient *kms.Client,
 	serviceConfig *config.ServiceConfig,
 	redisClient *redis.Client,
 	userService domain.Service,
+	passkeys *domain.Passkeys,
+	federation *domain.Federation,
+	passwordPolicy *domain.PasswordPolicy,
+	passwordHasher *domain.PasswordHasher,
 	emailQueue rmq.Queue) *Handler {
+	loginThrottle := domain.NewLoginThrottle(serviceConfig.LoginProtection, redisClient)
+	loginCodes := domain.NewLoginCodes(serviceConfig.EmailOTP, redisClient, loginThrottle)
 	return &Handler{
-		kmsClient:     kmsClient,
-		tmsClient:     tmsClient,
-		serviceConfig: serviceConfig,
-		redisClient:   redisClient,
-		emailQueue:    emailQueue,
-		userService:   userService,
+		kmsClient:        kmsClient,
+		tmsClient:        tmsClient,
+		serviceConfig:    serviceConfig,
+		redisClient:      redisClient,
+		emailQueue:       emailQueue,
+		userService:      userService,
+		passkeys:         passkeys,
+		federation:       federation,
+		loginThrottle:    loginThrottle,
+		loginCodes:       loginCodes,
+		mfaLogins:        domain.NewMFALogins(serviceConfig.MFA, redisClient, loginThrottle, userService),
+		reauthentication: domain.NewReauthentication(passwordHasher, loginThrottle, loginCodes),
+		passwordPolicy:   passwordPolicy,
+		passwordHasher:   passwordHasher,
 	}
 }
 
@@ -113,7 +134,11 @@ func (h *Handler) Register(c *gin.Context) {
 		return
 	}
 
-	password, err := bcrypt.GenerateFromPassword(decrypted[0], bcrypt.DefaultCost)
+	if !h.checkPasswordPolicy(c, "password", user.Email, decrypted[0]) {
+		return
+	}
+
+	password, err := h.passwordHasher.Hash(decrypted[0])
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"message": "unable to generate hash for password",
@@ -219,6 +244,10 @@ func (handler *Handler) VerifyEmail(c *gin.Context) {
 		return
 	}
 
+	if handler.completeEmailChange(c, verificationRequest.Code) {
+		return
+	}
+
 	ctx := c.Request.Context()
 	userDetails, err := handler.redisClient.Get(ctx, verificationRequest.Code).Result()
 	if err != nil {
@@ -2,10 +2,15 @@ package handlers
 
 import (
 	"context"
+	"database/sql"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,16 +18,70 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
-	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"user-management-service/apperror"
+	"user-management-service/domain"
 	"user-management-service/model"
 
 	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
 	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
 )
 
-// LoginWithPassword handles user login with email and password.
+// LoginChallenge starts the login of a login challenge with the login screen of the client. Users remembered by the
+// oauth2 server are accepted without asking for credentials again, the response then only has the redirect.
+func (h *Handler) LoginChallenge(c *gin.Context) {
+	request := model.LoginRequest{}
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	loginRequest, err := h.tmsClient.GetLoginRequest(ctx, &pb.GetLoginRequestRequest{LoginChallenge: request.LoginChallenge})
+	if err != nil {
+		h.abortWithLoginError(c, err, "unable to fetch login request")
+		return
+	}
+
+	if !loginRequest.Skip {
+		c.JSON(http.StatusOK, model.LoginPrompt{
+			LoginChallenge: request.LoginChallenge,
+			Client: model.OAuth2Client{
+				ID:      loginRequest.ClientID,
+				Name:    loginRequest.ClientName,
+				LogoURI: loginRequest.LogoURI,
+			},
+			RequestedScope: loginRequest.RequestedScope,
+			LoginHint:      loginRequest.LoginHint,
+		})
+		return
+	}
+
+	user, err := h.userService.GetUser(ctx, loginRequest.Subject)
+	if err == nil && (user.DeletedAt != nil || user.LockedAt != nil) {
+		err = domain.ErrUserLocked
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "user of remembered login is not available", slog.Any(constants.Error, err))
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "login session is no longer valid",
+		})
+		return
+	}
+
+	slog.InfoContext(ctx, "accepting remembered login without credentials")
+	// the token service accepts it with the acr and amr of the login which started the remembered login session.
+	h.acceptLogin(c, user, request.LoginChallenge, constants.ACRSingleFactor, nil, false)
+}
+
+// LoginWithPassword handles user login with email and password. Failed logins are counted per account and ip address,
+// unknown users and wrong passwords get the same response after the same hashing work. Password hashes below the
+// configured hashing policy are upgraded after a successful login.
 func (h *Handler) LoginWithPassword(c *gin.Context) {
 	login := model.Login{}
 	if err := c.ShouldBindJSON(&login); err != nil {
@@ -33,6 +92,10 @@ func (h *Handler) LoginWithPassword(c *gin.Context) {
 	}
 	ctx := c.Request.Context()
 
+	if h.refuseDuringLockout(c, login.Email) {
+		return
+	}
+
 	decodedText, err := base64.StdEncoding.DecodeString(login.Password)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
@@ -56,57 +119,226 @@ func (h *Handler) LoginWithPassword(c *gin.Context) {
 	}
 
 	user, err := h.userService.GetUserByEmail(ctx, login.Email)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"message": err.Error(),
+	// locked accounts are only told apart after the password matched, everyone else gets the generic login error.
+	locked := errors.Is(err, domain.ErrUserLocked)
+	if err != nil && !locked {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			h.passwordHasher.VerifyUnknownUser(output.Plaintext)
+			h.failLogin(c, nil, login.Email)
+		default:
+			slog.ErrorContext(ctx, "unable to fetch user for login", slog.Any(constants.Error, err))
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to login",
+			})
+		}
+		return
+	}
+
+	matches, err := h.passwordHasher.Verify(user.Password, output.Plaintext)
+	if err != nil && len(user.Password) != 0 {
+		// users provisioned by federated login have no password, any other unreadable hash is worth a look.
+		slog.ErrorContext(ctx, "unable to verify password hash", slog.Any(constants.Error, err))
+	}
+	if !matches {
+		h.failLogin(c, user, login.Email)
+		return
+	}
+	if locked {
+		slog.ErrorContext(ctx, "login attempted on locked account")
+		c.JSON(http.StatusForbidden, gin.H{
+			"message": "account is locked, please contact support",
 		})
 		return
 	}
+	h.rehashPassword(ctx, user, output.Plaintext)
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), output.Plaintext); err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"message": err.Error(),
+	if user.PasswordResetRequired {
+		slog.InfoContext(ctx, "login rejected until password is reset")
+		c.JSON(http.StatusForbidden, gin.H{
+			"message": "password reset required, please use the reset link sent to your email",
 		})
 		return
 	}
 
-	acceptLogin, err := h.tmsClient.AcceptLogin(context.Background(), &pb.AcceptLoginRequest{
-		LoginChallenge: login.LoginChallenge,
-		UserProfile: &pb.UserProfile{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-		},
-	})
-	if err != nil {
+	h.loginWithFirstFactor(c, user, login.LoginChallenge, constants.AMRPassword, login.Remember)
+}
+
+// loginWithFirstFactor continues a login verified with its first factor, users enrolled in totp have to verify their
+// second factor before the login challenge is accepted.
+func (h *Handler) loginWithFirstFactor(c *gin.Context, user *model.User, loginChallenge, firstFactor string, remember bool) {
+	ctx := c.Request.Context()
+	totp, err := h.userService.GetTOTP(ctx, user.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		slog.ErrorContext(ctx, "unable to fetch totp enrollment of user", slog.Any(constants.Error, err))
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"message": "unable to login",
 		})
 		return
 	}
+	if totp != nil && totp.Enabled {
+		h.startMFALogin(c, user.ID, loginChallenge, firstFactor, remember)
+		return
+	}
 
-	c.JSON(http.StatusOK, model.AcceptLogin{RedirectTo: acceptLogin.RedirectTo})
+	h.acceptLogin(c, user, loginChallenge, constants.ACRSingleFactor, []string{firstFactor}, remember)
 }
 
-// ConsentChallenge initiates and accept user login consent.
-func (h *Handler) ConsentChallenge(c *gin.Context) {
-	consentRequest := model.ConsentRequest{}
-	if err := c.ShouldBindQuery(&consentRequest); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"message": apperror.CustomValidationError(err),
+// rehashPassword upgrades the stored password hash when it uses another algorithm or weaker parameters than configured.
+// The login succeeds regardless, a failed upgrade is retried at the next login.
+func (h *Handler) rehashPassword(ctx context.Context, user *model.User, password []byte) {
+	if !h.passwordHasher.NeedsRehash(user.Password) {
+		return
+	}
+	hash, err := h.passwordHasher.Hash(password)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to rehash password", slog.Any(constants.Error, err))
+		return
+	}
+	if err := h.userService.RehashPassword(ctx, user.ID, user.Password, hash); err != nil {
+		slog.ErrorContext(ctx, "unable to store rehashed password", slog.Any(constants.Error, err))
+		return
+	}
+	user.Password = hash
+}
+
+// refuseDuringLockout responds with the remaining lockout when too many logins of the account or from the ip address
+// failed, it reports whether the login was refused.
+func (h *Handler) refuseDuringLockout(c *gin.Context, email string) bool {
+	ctx := c.Request.Context()
+	lockout, err := h.loginThrottle.Lockout(ctx, email, c.ClientIP())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
+		})
+		return true
+	}
+	if lockout > 0 {
+		slog.InfoContext(ctx, "login refused during lockout")
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(lockout.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"message": "too many failed login attempts, please try again later",
 		})
+		return true
+	}
+
+	return false
+}
+
+// failLogin records a failed password login and responds with the generic login error.
+// The user is notified when their account gets locked, user is nil for unknown accounts.
+func (h *Handler) failLogin(c *gin.Context, user *model.User, email string) {
+	ctx := c.Request.Context()
+	locked, err := h.loginThrottle.Fail(ctx, email, c.ClientIP())
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to record failed login", slog.Any(constants.Error, err))
+	}
+	if locked && user != nil {
+		h.alertAccountLockout(c, user.Email)
+	}
+
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"message": "invalid email or password",
+	})
+}
+
+// alertAccountLockout notifies the user that their account got locked by failed logins.
+func (h *Handler) alertAccountLockout(c *gin.Context, email string) {
+	h.publishSecurityAlert(c.Request.Context(), email, model.SecurityAlertPayload{
+		Reason:     model.AccountLockoutReason,
+		UserAgent:  c.Request.UserAgent(),
+		IPAddress:  c.ClientIP(),
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+// publishSecurityAlert notifies the user about suspicious activity on their account.
+func (h *Handler) publishSecurityAlert(ctx context.Context, email string, payload model.SecurityAlertPayload) {
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	payloadBytes, _ := json.Marshal(payload)
+	eventBytes, _ := json.Marshal(model.Event{
+		Email:        email,
+		Type:         model.SecurityAlertEvent,
+		EventPayload: payloadBytes,
+	})
+
+	if err := h.emailQueue.PublishBytes(eventBytes); err != nil {
+		slog.ErrorContext(ctx, "unable to publish security alert event", slog.Any(constants.Error, err))
 		return
 	}
 
-	consent, err := h.tmsClient.AcceptConsent(context.Background(), &pb.AcceptConsentRequest{ConsentChallenge: consentRequest.ConsentChallenge})
+	slog.InfoContext(ctx, "published security alert event", slog.String("reason", payload.Reason))
+}
+
+// acceptLogin accepts the oauth2 login challenge for an authenticated user, the acr and amr
+// tell the oauth2 server how the user authenticated and remember whether to keep the user signed in.
+// An upstream identity waiting for the login challenge is linked once its user logged in. The failed logins of the
+// account are only forgotten here, once every factor of the login is verified.
+func (h *Handler) acceptLogin(c *gin.Context, user *model.User, loginChallenge, acr string, amr []string, remember bool) {
+	ctx := c.Request.Context()
+	if err := h.loginThrottle.Reset(ctx, user.Email); err != nil {
+		slog.ErrorContext(ctx, "unable to reset failed logins", slog.Any(constants.Error, err))
+	}
+
+	roles, err := h.userService.GetUserRoles(ctx, user.ID)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"message": err.Error(),
+		slog.ErrorContext(ctx, "unable to fetch roles of user", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
+		})
+		return
+	}
+	permissions, err := h.userService.GetUserPermissions(ctx, user.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch permissions of user", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, model.AcceptLogin{RedirectTo: consent.RedirectTo})
+	acceptLogin, err := h.tmsClient.AcceptLogin(context.Background(), &pb.AcceptLoginRequest{
+		LoginChallenge: loginChallenge,
+		UserProfile: &pb.UserProfile{
+			ID:          user.ID,
+			Email:       user.Email,
+			Name:        user.Name,
+			Roles:       roles,
+			Permissions: permissions,
+		},
+		Acr:      acr,
+		Amr:      amr,
+		Remember: remember,
+	})
+	if err != nil {
+		h.abortWithLoginError(c, err, "unable to accept login")
+		return
+	}
+
+	if err := h.federation.ConfirmLink(ctx, loginChallenge, user.ID); err != nil {
+		// the login itself succeeded, the identity can be linked by logging in with it again.
+		slog.ErrorContext(ctx, "unable to confirm identity link", slog.Any(constants.Error, err))
+	}
+
+	c.JSON(http.StatusOK, model.AcceptLogin{RedirectTo: acceptLogin.RedirectTo})
+}
+
+func (h *Handler) abortWithLoginError(c *gin.Context, err error, message string) {
+	slog.ErrorContext(c.Request.Context(), message, slog.Any(constants.Error, err))
+	switch status.Code(err) {
+	case codes.NotFound:
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "login challenge is invalid or expired",
+		})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{
+			"message": "login challenge was already used",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
+		})
+	}
 }
 
 // Exchange exchanges a code for access token.
@@ -124,6 +356,9 @@ func (h *Handler) Exchange(c *gin.Context) {
 		RedirectURI:  request.RedirectURI,
 		ClientID:     request.ClientID,
 		CodeVerifier: request.CodeVerifier,
+		UserAgent:    c.Request.UserAgent(),
+		IPAddress:    c.ClientIP(),
+		Nonce:        request.Nonce,
 	})
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -146,6 +381,59 @@ func (h *Handler) Exchange(c *gin.Context) {
 	c.JSON(http.StatusOK, tokenExchangeResponse)
 }
 
+// Logout revokes the tokens of the current session and clears the session cookies.
+func (h *Handler) Logout(c *gin.Context) {
+	request := model.Logout{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": apperror.CustomValidationError(err),
+			})
+			return
+		}
+	}
+	ctx := c.Request.Context()
+	session := c.MustGet(constants.SessionContext).(models.Session)
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	if _, err := h.tmsClient.RevokeAccessToken(ctx, &pb.RevokeAccessTokenRequest{
+		ClientID:    session.ClientID,
+		AccessToken: session.AccessToken,
+		SessionID:   session.ID,
+	}); err != nil {
+		slog.ErrorContext(ctx, "unable to revoke access token", slog.Any(constants.Error, err))
+		if status.Code(err) == codes.NotFound {
+			clearAuthCookies(c)
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "missing/invalid authentication headers",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to logout",
+		})
+		return
+	}
+
+	if request.RevokeLoginSessions {
+		if _, err := h.tmsClient.RevokeLoginSessions(ctx, &pb.RevokeLoginSessionsRequest{
+			Subject: userProfile.ID.String(),
+		}); err != nil {
+			slog.ErrorContext(ctx, "unable to revoke login sessions", slog.Any(constants.Error, err))
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to logout",
+			})
+			return
+		}
+	}
+
+	clearAuthCookies(c)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+	})
+}
+
 func (h *Handler) setAuthCookies(c *gin.Context, response *model.TokenExchangeResponse) {
 	c.Writer.Header().Add("set-cookie", fmt.Sprintf("access_token=%s; Path=/; Max-Age=%d", response.AccessToken, response.ExpiresIn))
 	// Session should be alive till refresh token expires.
@@ -153,6 +441,11 @@ func (h *Handler) setAuthCookies(c *gin.Context, response *model.TokenExchangeRe
 	c.Writer.Header().Add("set-cookie", fmt.Sprintf("session=%s; Path=/; Max-Age=%d", response.SessionID, expiresAt.Unix()))
 }
 
+func clearAuthCookies(c *gin.Context) {
+	c.Writer.Header().Add("set-cookie", "access_token=; Path=/; Max-Age=-1")
+	c.Writer.Header().Add("set-cookie", "session=; Path=/; Max-Age=-1")
+}
+
 func clearCsrfCookies(c *gin.Context) {
 	c.Writer.Header().Add("set-cookie", "oauth2_authentication_csrf=; Path=/; Max-Age=-1")
 	c.Writer.Header().Add("set-cookie", "oauth2_authentication_session=; Path=/; Max-Age=-1")
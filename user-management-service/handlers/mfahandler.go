@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pquerna/otp/totp"
+
+	"user-management-service/apperror"
+	"user-management-service/domain"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// EnrollTOTP starts the totp enrollment of the signed-in user and returns the secret
+// and provisioning uri to be added to an authenticator app.
+func (h *Handler) EnrollTOTP(c *gin.Context) {
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      h.serviceConfig.MFA.Issuer,
+		AccountName: userProfile.Email,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to generate totp secret", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to enroll totp",
+		})
+		return
+	}
+
+	encryptedSecret, err := h.encrypt(ctx, h.serviceConfig.MFA.SecretKeyID, []byte(key.Secret()))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to enroll totp",
+		})
+		return
+	}
+
+	if err := h.userService.SaveTOTPSecret(ctx, userProfile.ID.String(), encryptedSecret); err != nil {
+		slog.ErrorContext(ctx, "unable to save totp secret", slog.Any(constants.Error, err))
+		if errors.Is(err, domain.ErrTOTPAlreadyEnabled) {
+			c.JSON(http.StatusConflict, gin.H{
+				"message": "totp is already enabled",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to enroll totp",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.TOTPEnrollment{
+		Secret:          key.Secret(),
+		ProvisioningURI: key.URL(),
+	})
+}
+
+// ConfirmTOTP enables totp for the signed-in user once a code from the authenticator app is verified
+// and returns the recovery codes, which are not shown again.
+func (h *Handler) ConfirmTOTP(c *gin.Context) {
+	request := model.MFACode{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+	userID := userProfile.ID.String()
+
+	enrollment, err := h.userService.GetTOTP(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch totp enrollment", slog.Any(constants.Error, err))
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": "totp enrollment not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to confirm totp",
+		})
+		return
+	}
+	if enrollment.Enabled {
+		c.JSON(http.StatusConflict, gin.H{
+			"message": "totp is already enabled",
+		})
+		return
+	}
+
+	secret, err := h.decrypt(ctx, h.serviceConfig.MFA.SecretKeyID, enrollment.EncryptedSecret)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to confirm totp",
+		})
+		return
+	}
+	if err := h.mfaLogins.VerifyTOTP(ctx, userID, string(secret), request.Code); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "invalid code",
+		})
+		return
+	}
+
+	codes, hashes, err := domain.GenerateRecoveryCodes()
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to generate recovery codes", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to confirm totp",
+		})
+		return
+	}
+
+	if err := h.userService.EnableTOTP(ctx, userID, hashes); err != nil {
+		slog.ErrorContext(ctx, "unable to enable totp", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to confirm totp",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.RecoveryCodes{Codes: codes})
+}
+
+// DisableTOTP disables totp for the signed-in user after verifying a totp or recovery code.
+func (h *Handler) DisableTOTP(c *gin.Context) {
+	request := model.MFACode{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+	userID := userProfile.ID.String()
+
+	enrollment, err := h.userService.GetTOTP(ctx, userID)
+	if err != nil || !enrollment.Enabled {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": "totp is not enabled",
+		})
+		return
+	}
+
+	secret, err := h.decrypt(ctx, h.serviceConfig.MFA.SecretKeyID, enrollment.EncryptedSecret)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to disable totp",
+		})
+		return
+	}
+	if _, err := h.mfaLogins.Verify(ctx, userID, string(secret), request.Code, constants.AMRPassword); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "invalid code",
+		})
+		return
+	}
+
+	if err := h.userService.DisableTOTP(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "unable to disable totp", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to disable totp",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// LoginWithMFA completes a password or login code verified login with a totp or recovery code
+// and accepts the login challenge held for it. Wrong codes count as failed logins of the account.
+func (h *Handler) LoginWithMFA(c *gin.Context) {
+	request := model.LoginMFA{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	pending, err := h.mfaLogins.Get(ctx, request.MFAToken)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "login expired, please login again",
+		})
+		return
+	}
+
+	user, err := h.userService.GetUser(ctx, pending.UserID)
+	if err == nil && (user.DeletedAt != nil || user.LockedAt != nil) {
+		err = domain.ErrUserLocked
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "user of pending mfa login is not available", slog.Any(constants.Error, err))
+		h.mfaLogins.Cancel(ctx, request.MFAToken)
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "login expired, please login again",
+		})
+		return
+	}
+	if h.refuseDuringLockout(c, user.Email) {
+		return
+	}
+
+	enrollment, err := h.userService.GetTOTP(ctx, pending.UserID)
+	if err != nil || !enrollment.Enabled {
+		slog.ErrorContext(ctx, "totp of pending mfa login is not enabled", slog.Any(constants.Error, err))
+		h.mfaLogins.Cancel(ctx, request.MFAToken)
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "login expired, please login again",
+		})
+		return
+	}
+	secret, err := h.decrypt(ctx, h.serviceConfig.MFA.SecretKeyID, enrollment.EncryptedSecret)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
+		})
+		return
+	}
+
+	amr, locked, err := h.mfaLogins.Complete(ctx, pending, user.Email, string(secret), request.Code, c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrMFALoginAttempts):
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "too many attempts, please login again",
+			})
+		case errors.Is(err, domain.ErrInvalidMFACode):
+			if locked {
+				h.alertAccountLockout(c, user.Email)
+			}
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "invalid code",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to login",
+			})
+		}
+		return
+	}
+
+	h.acceptLogin(c, user, pending.LoginChallenge, constants.ACRMultiFactor, amr, pending.Remember)
+}
+
+// startMFALogin holds the login challenge of a login verified with its first factor until the second factor is verified.
+func (h *Handler) startMFALogin(c *gin.Context, userID, loginChallenge, firstFactor string, remember bool) {
+	mfaToken, err := h.mfaLogins.Start(c.Request.Context(), domain.PendingMFALogin{
+		UserID:         userID,
+		LoginChallenge: loginChallenge,
+		FirstFactor:    firstFactor,
+		Remember:       remember,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
+		})
+		return
+	}
+
+	slog.InfoContext(c.Request.Context(), "first factor verified, waiting for second factor")
+
+	c.JSON(http.StatusOK, model.MFARequired{
+		MFARequired: true,
+		MFAToken:    mfaToken,
+	})
+}
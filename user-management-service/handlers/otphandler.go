@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"user-management-service/apperror"
+	"user-management-service/domain"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// loginCodeSent is the response of a login code request, it is the same whether or not the account
+// exists so that the endpoint can't be used to discover registered emails.
+var loginCodeSent = gin.H{
+	"message": "if an account exists for this email, a login code has been sent",
+}
+
+// RequestLoginCode sends a one-time code to the email of the user for a passwordless login of the oauth2 login challenge.
+func (h *Handler) RequestLoginCode(c *gin.Context) {
+	request := model.LoginCodeRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	if _, err := h.userService.GetUserByEmail(ctx, request.Email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserLocked) {
+			slog.InfoContext(ctx, "login code requested for unavailable account")
+			c.JSON(http.StatusAccepted, loginCodeSent)
+			return
+		}
+		slog.ErrorContext(ctx, "unable to fetch user for login code", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to send login code",
+		})
+		return
+	}
+
+	code, err := h.loginCodes.Issue(ctx, request.Email, request.LoginChallenge)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to send login code",
+		})
+		return
+	}
+	if len(code) == 0 {
+		c.JSON(http.StatusAccepted, loginCodeSent)
+		return
+	}
+
+	if err := h.sendLoginCode(ctx, request.Email, code, h.loginCodes.CodeExpiry()); err != nil {
+		h.loginCodes.Withdraw(ctx, request.Email, request.LoginChallenge)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to send login code",
+		})
+		return
+	}
+
+	slog.InfoContext(ctx, "login code sent")
+
+	c.JSON(http.StatusAccepted, loginCodeSent)
+}
+
+// LoginWithCode completes a passwordless login with the one-time code sent by email. Wrong codes count as failed
+// logins of the account like wrong passwords, so the lockout applies across newly requested codes.
+func (h *Handler) LoginWithCode(c *gin.Context) {
+	request := model.LoginCode{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	if h.refuseDuringLockout(c, request.Email) {
+		return
+	}
+
+	email, err := h.loginCodes.Verify(ctx, request.Email, request.LoginChallenge, request.Code, c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLoginCodeInvalid):
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "login code is invalid or expired",
+			})
+		case errors.Is(err, domain.ErrLoginCodeAttempts):
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "too many attempts, please request a new code",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to login",
+			})
+		}
+		return
+	}
+
+	user, err := h.userService.GetUserByEmail(ctx, email)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserLocked):
+			slog.ErrorContext(ctx, "login attempted on locked account")
+			c.JSON(http.StatusForbidden, gin.H{
+				"message": "account is locked, please contact support",
+			})
+		case errors.Is(err, sql.ErrNoRows):
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "login code is invalid or expired",
+			})
+		default:
+			slog.ErrorContext(ctx, "unable to fetch user for login", slog.Any(constants.Error, err))
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to login",
+			})
+		}
+		return
+	}
+
+	h.loginWithFirstFactor(c, user, request.LoginChallenge, constants.AMROneTimePassword, request.Remember)
+}
+
+// sendLoginCode publishes the login code event rendered and sent by the customer communication service.
+func (h *Handler) sendLoginCode(ctx context.Context, email, code string, codeExpiry time.Duration) error {
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	payload, _ := json.Marshal(model.LoginCodePayload{
+		Code:             code,
+		ExpiresInMinutes: int(codeExpiry.Minutes()),
+	})
+	eventBytes, _ := json.Marshal(model.Event{
+		Email:        email,
+		Type:         model.LoginCodeEvent,
+		EventPayload: payload,
+	})
+
+	if err := h.emailQueue.PublishBytes(eventBytes); err != nil {
+		slog.ErrorContext(ctx, "unable to publish login code event", slog.Any(constants.Error, err))
+		return err
+	}
+
+	return nil
+}
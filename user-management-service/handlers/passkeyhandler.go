@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"user-management-service/apperror"
+	"user-management-service/domain"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// BeginPasskeyRegistration returns the credential creation options for registering a passkey of the signed-in user.
+func (h *Handler) BeginPasskeyRegistration(c *gin.Context) {
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	creation, err := h.passkeys.BeginRegistration(ctx, userProfile.ID.String())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to register passkey",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, creation)
+}
+
+// FinishPasskeyRegistration verifies the authenticator response and stores the passkey of the signed-in user.
+func (h *Handler) FinishPasskeyRegistration(c *gin.Context) {
+	request := model.PasskeyRegistration{}
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	passkey, err := h.passkeys.FinishRegistration(ctx, userProfile.ID.String(), request.Name, c.Request.Body)
+	if err != nil {
+		abortWithPasskeyError(c, err, "unable to register passkey")
+		return
+	}
+
+	c.JSON(http.StatusCreated, toPasskeyResponse(*passkey))
+}
+
+// Passkeys lists the passkeys registered by the signed-in user.
+func (h *Handler) Passkeys(c *gin.Context) {
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	passkeys, err := h.userService.GetPasskeys(ctx, userProfile.ID.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list passkeys", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to list passkeys",
+		})
+		return
+	}
+
+	response := make([]model.PasskeyResponse, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		response = append(response, toPasskeyResponse(passkey))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeletePasskey removes a passkey of the signed-in user.
+func (h *Handler) DeletePasskey(c *gin.Context) {
+	request := model.PasskeyRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	credentialID, err := base64.RawURLEncoding.DecodeString(request.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "invalid passkey id",
+		})
+		return
+	}
+
+	if err := h.userService.DeletePasskey(ctx, userProfile.ID.String(), credentialID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": "passkey not found",
+			})
+			return
+		}
+		slog.ErrorContext(ctx, "unable to delete passkey", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to delete passkey",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// BeginPasskeyLogin returns the credential request options for a passwordless login of the oauth2 login challenge.
+func (h *Handler) BeginPasskeyLogin(c *gin.Context) {
+	request := model.PasskeyLogin{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+
+	assertion, err := h.passkeys.BeginLogin(c.Request.Context(), request.LoginChallenge, request.Remember)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to login",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, assertion)
+}
+
+// FinishPasskeyLogin verifies the authenticator assertion and accepts the oauth2 login challenge of the user.
+func (h *Handler) FinishPasskeyLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	authentication, err := h.passkeys.FinishLogin(ctx, c.Request.Body)
+	if err != nil {
+		abortWithPasskeyError(c, err, "unable to login")
+		return
+	}
+
+	user := authentication.User
+	if user.DeletedAt != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "invalid passkey",
+		})
+		return
+	}
+	if user.LockedAt != nil {
+		slog.ErrorContext(ctx, "login attempted on locked account")
+		c.JSON(http.StatusForbidden, gin.H{
+			"message": "account is locked, please contact support",
+		})
+		return
+	}
+
+	h.acceptLogin(c, user, authentication.LoginChallenge, authentication.Acr, authentication.Amr, authentication.Remember)
+}
+
+func abortWithPasskeyError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrCeremonyExpired):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "passkey challenge expired, please try again",
+		})
+	case errors.Is(err, domain.ErrPasskeyInvalid):
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": "invalid passkey",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": message,
+		})
+	}
+}
+
+func toPasskeyResponse(passkey model.Passkey) model.PasskeyResponse {
+	return model.PasskeyResponse{
+		ID:         base64.RawURLEncoding.EncodeToString(passkey.ID),
+		Name:       passkey.Name,
+		CreatedAt:  passkey.CreatedAt,
+		LastUsedAt: passkey.LastUsedAt,
+	}
+}
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"user-management-service/apperror"
+	"user-management-service/domain"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+)
+
+// ForgotPassword generates a password reset token and sends it to the user's email.
+// The response is the same whether the email is registered or not.
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	request := model.ForgotPassword{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	if _, err := h.userService.GetUserByEmail(ctx, request.Email); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) && !errors.Is(err, domain.ErrUserLocked) {
+			slog.ErrorContext(ctx, "unable to fetch user for forgot password", slog.Any(constants.Error, err))
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": "Please try after sometime",
+			})
+			return
+		}
+		slog.InfoContext(ctx, "forgot password requested for unknown or locked email")
+		c.JSON(http.StatusOK, gin.H{
+			"status": "success",
+		})
+		return
+	}
+
+	if err := h.sendPasswordReset(ctx, request.Email); err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "Please try after sometime",
+			})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+	})
+}
+
+// sendPasswordReset generates a password reset token and publishes the reset password email event.
+func (h *Handler) sendPasswordReset(ctx context.Context, email string) error {
+	verificationToken, err := h.tmsClient.GenerateVerificationToken(ctx, &pb.GenerateVerificationTokenRequest{
+		Email:    email,
+		ClientID: h.serviceConfig.ForgotPasswordClientID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to generate reset password token", slog.Any(constants.Error, err))
+		return err
+	}
+
+	event := model.Event{
+		Email: email,
+		Type:  model.ResetPasswordEvent,
+		EventPayload: []byte(fmt.Sprintf(`{
+	"resetToken": "%s"
+}`, verificationToken.AccessToken)),
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if err = h.emailQueue.PublishBytes(eventBytes); err != nil {
+		slog.ErrorContext(ctx, "unable to publish reset password event", slog.Any(constants.Error, err))
+		return err
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password for the user owning a valid password reset token.
+func (h *Handler) ResetPassword(c *gin.Context) {
+	request := model.ResetPassword{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	introspection, err := h.tmsClient.IntrospectVerificationToken(ctx, &pb.IntrospectVerificationRequest{
+		AccessToken: request.Token,
+	})
+	if err != nil || !introspection.Active || introspection.ClientID != h.serviceConfig.ForgotPasswordClientID {
+		slog.ErrorContext(ctx, "reset password token is invalid", slog.Any(constants.Error, err))
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"message": "reset link is invalid or expired",
+		})
+		return
+	}
+
+	newPassword, err := h.decryptPassword(ctx, request.NewPassword)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	confirmPassword, err := h.decryptPassword(ctx, request.ConfirmPassword)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if string(newPassword) != string(confirmPassword) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"message": "password and confirm password is not matching",
+		})
+		return
+	}
+
+	if !h.checkPasswordPolicy(c, "newPassword", introspection.Email, newPassword) {
+		return
+	}
+
+	// reset token is single use and consumed before the password is changed, so concurrent requests can't both use
+	// it. The token itself is the key it is cached against, only one revocation of it succeeds.
+	if _, err := h.tmsClient.RevokeAccessToken(ctx, &pb.RevokeAccessTokenRequest{
+		ClientID:    introspection.ClientID,
+		AccessToken: request.Token,
+		SessionID:   request.Token,
+	}); err != nil {
+		slog.ErrorContext(ctx, "unable to revoke reset password token", slog.Any(constants.Error, err))
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"message": "reset link is invalid or expired",
+		})
+		return
+	}
+
+	password, err := h.passwordHasher.Hash(newPassword)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to generate hash for password",
+		})
+		return
+	}
+
+	if err := h.userService.UpdatePassword(ctx, introspection.Email, string(password)); err != nil {
+		slog.ErrorContext(ctx, "unable to update password", slog.Any(constants.Error, err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+	})
+}
+
+// checkPasswordPolicy validates a new password of the user against the password policy, responding
+// with the violations as errors of the field when it is rejected.
+func (h *Handler) checkPasswordPolicy(c *gin.Context, field, email string, password []byte) bool {
+	ctx := c.Request.Context()
+	violations, err := h.passwordPolicy.Validate(ctx, email, password)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to validate password policy", slog.Any(constants.Error, err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to validate password",
+		})
+		return false
+	}
+	if len(violations) != 0 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"message": apperror.FieldErrors(field, violations),
+		})
+		return false
+	}
+
+	return true
+}
+
+// decryptPassword decodes and decrypts a base64 KMS encrypted password.
+func (h *Handler) decryptPassword(ctx context.Context, encryptedText string) ([]byte, error) {
+	return h.decrypt(ctx, h.serviceConfig.LoginPasswordKeyID, encryptedText)
+}
+
+// decrypt decodes and decrypts a base64 text encrypted with the given KMS key.
+func (h *Handler) decrypt(ctx context.Context, keyID, encryptedText string) ([]byte, error) {
+	decodedText, err := base64.StdEncoding.DecodeString(encryptedText)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.kmsClient.Decrypt(ctx, &kms.DecryptInput{
+		CiphertextBlob:      decodedText,
+		EncryptionAlgorithm: types.EncryptionAlgorithmSpecRsaesOaepSha256,
+		EncryptionContext:   map[string]string{},
+		KeyId:               aws.String(keyID),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to decrypt with kms key", slog.Any(constants.Error, err))
+		return nil, err
+	}
+
+	return output.Plaintext, nil
+}
+
+// encrypt encrypts the plain text with the given KMS key and encodes it as base64.
+func (h *Handler) encrypt(ctx context.Context, keyID string, plainText []byte) (string, error) {
+	output, err := h.kmsClient.Encrypt(ctx, &kms.EncryptInput{
+		Plaintext:           plainText,
+		EncryptionAlgorithm: types.EncryptionAlgorithmSpecRsaesOaepSha256,
+		EncryptionContext:   map[string]string{},
+		KeyId:               aws.String(keyID),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to encrypt with kms key", slog.Any(constants.Error, err))
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(output.CiphertextBlob), nil
+}
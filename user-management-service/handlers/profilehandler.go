@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"user-management-service/apperror"
+	umsConstants "user-management-service/constants"
+	"user-management-service/domain"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// UpdateProfile updates the display name of the signed-in user.
+func (h *Handler) UpdateProfile(c *gin.Context) {
+	request := model.UpdateProfile{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	if err := h.userService.UpdateName(ctx, userProfile.ID.String(), strings.TrimSpace(request.Name)); err != nil {
+		h.abortWithUserError(c, err, "unable to update profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+	})
+}
+
+// ChangePassword changes the password of the signed-in user after checking their current password.
+// All other sessions of the user are signed out, the session changing the password stays signed in.
+func (h *Handler) ChangePassword(c *gin.Context) {
+	request := model.ChangePassword{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	session := c.MustGet(constants.SessionContext).(models.Session)
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	user, err := h.userService.GetUser(ctx, userProfile.ID.String())
+	if err != nil {
+		h.abortWithUserError(c, err, "unable to fetch user for password change")
+		return
+	}
+
+	if !h.reauthenticateWithPassword(c, user, "oldPassword", request.OldPassword) {
+		return
+	}
+
+	passwords := make([][]byte, 0, 2)
+	for _, encrypted := range []string{request.NewPassword, request.ConfirmPassword} {
+		password, err := h.decryptPassword(ctx, encrypted)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		passwords = append(passwords, password)
+	}
+	newPassword, confirmPassword := passwords[0], passwords[1]
+
+	if string(newPassword) != string(confirmPassword) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "password and confirm password is not matching",
+		})
+		return
+	}
+
+	if !h.checkPasswordPolicy(c, "newPassword", user.Email, newPassword) {
+		return
+	}
+
+	password, err := h.passwordHasher.Hash(newPassword)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to generate hash for password",
+		})
+		return
+	}
+
+	if err := h.userService.UpdatePassword(ctx, user.Email, password); err != nil {
+		slog.ErrorContext(ctx, "unable to update password", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return
+	}
+
+	h.revokeOtherSessions(ctx, user.ID, session)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+	})
+}
+
+// RequestReauthenticationCode sends a code to the email of the signed-in user which confirms a sensitive change in place
+// of their password, users without a password have no other way to confirm it.
+func (h *Handler) RequestReauthenticationCode(c *gin.Context) {
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	user, err := h.userService.GetUser(ctx, userProfile.ID.String())
+	if err != nil {
+		h.abortWithUserError(c, err, "unable to fetch user for re-authentication")
+		return
+	}
+
+	code, err := h.reauthentication.IssueCode(ctx, user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to send code",
+		})
+		return
+	}
+	if len(code) != 0 {
+		if err := h.sendLoginCode(ctx, user.Email, code, h.reauthentication.CodeExpiry()); err != nil {
+			h.reauthentication.WithdrawCode(ctx, user)
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to send code",
+			})
+			return
+		}
+		slog.InfoContext(ctx, "re-authentication code sent")
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "a code has been sent to your email",
+	})
+}
+
+// reauthenticate confirms the identity of the signed-in user before a sensitive change with the code sent to their
+// email when given, otherwise with their KMS encrypted password. The response is written when it reports false.
+func (h *Handler) reauthenticate(c *gin.Context, user *model.User, encryptedPassword, code string) bool {
+	if len(code) == 0 {
+		return h.reauthenticateWithPassword(c, user, "password", encryptedPassword)
+	}
+
+	ctx := c.Request.Context()
+	if h.refuseDuringLockout(c, user.Email) {
+		return false
+	}
+	if err := h.reauthentication.VerifyCode(ctx, user, code, c.ClientIP()); err != nil {
+		if errors.Is(err, domain.ErrReauthenticationFailed) {
+			slog.InfoContext(ctx, "re-authentication rejected for invalid code")
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": apperror.FieldErrors("code", []string{"is invalid or expired"}),
+			})
+			return false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return false
+	}
+
+	return true
+}
+
+// reauthenticateWithPassword confirms the KMS encrypted password of the signed-in user before a sensitive change,
+// wrong passwords count as failed logins of the account. The response is written when it reports false.
+func (h *Handler) reauthenticateWithPassword(c *gin.Context, user *model.User, field, encryptedPassword string) bool {
+	ctx := c.Request.Context()
+	if h.refuseDuringLockout(c, user.Email) {
+		return false
+	}
+
+	password, err := h.decryptPassword(ctx, encryptedPassword)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return false
+	}
+
+	locked, err := h.reauthentication.VerifyPassword(ctx, user, password, c.ClientIP())
+	if locked {
+		h.alertAccountLockout(c, user.Email)
+	}
+	if err != nil {
+		slog.InfoContext(ctx, "re-authentication rejected for incorrect password")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.FieldErrors(field, []string{"is incorrect"}),
+		})
+		return false
+	}
+
+	return true
+}
+
+// revokeOtherSessions revokes every session of the user except the current one, the current session is identified
+// by its access token so that a session id sent along can't keep another session signed in.
+func (h *Handler) revokeOtherSessions(ctx context.Context, userID string, current models.Session) {
+	response, err := h.tmsClient.ListSessions(ctx, &pb.ListSessionsRequest{
+		UserID:             userID,
+		CurrentSessionID:   current.ID,
+		CurrentAccessToken: current.AccessToken,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list sessions of user", slog.Any(constants.Error, err))
+		return
+	}
+
+	for _, session := range response.Sessions {
+		if session.Current {
+			continue
+		}
+		if _, err := h.tmsClient.RevokeSession(ctx, &pb.RevokeSessionRequest{
+			UserID: userID,
+			ID:     session.ID,
+		}); err != nil {
+			slog.ErrorContext(ctx, "unable to revoke session of user", slog.Any(constants.Error, err))
+		}
+	}
+}
+
+// ChangeEmail starts changing the email of the signed-in user after re-authenticating them. A verification link is
+// sent to the new email, the email is only changed once it is verified, and the current email is notified about the change.
+func (h *Handler) ChangeEmail(c *gin.Context) {
+	request := model.ChangeEmail{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	user, err := h.userService.GetUser(ctx, userProfile.ID.String())
+	if err != nil {
+		h.abortWithUserError(c, err, "unable to fetch user for email change")
+		return
+	}
+	if !h.reauthenticate(c, user, request.Password, request.Code) {
+		return
+	}
+
+	if _, err := h.userService.GetUserByEmail(ctx, request.Email); !errors.Is(err, sql.ErrNoRows) {
+		if err != nil && !errors.Is(err, domain.ErrUserLocked) {
+			slog.ErrorContext(ctx, "unable to fetch user for email change", slog.Any(constants.Error, err))
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Please try after sometime",
+			})
+			return
+		}
+		c.JSON(http.StatusConflict, gin.H{
+			"message": apperror.FieldErrors("email", []string{"is already in use"}),
+		})
+		return
+	}
+
+	verificationID := uuid.NewString()
+	// Suppressing marshal errors since marshaling errors are unlikely for manually constructed objects.
+	emailChange, _ := json.Marshal(model.EmailChange{UserID: userProfile.ID.String(), Email: request.Email})
+	if err := h.redisClient.Set(ctx, emailChangeKey(verificationID), emailChange, time.Minute*h.serviceConfig.VerificationLinkExpiry).Err(); err != nil {
+		slog.ErrorContext(ctx, "unable to store pending email change", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return
+	}
+
+	payload, _ := json.Marshal(map[string]string{"verificationID": verificationID})
+	event, _ := json.Marshal(model.Event{
+		Email:        request.Email,
+		Type:         model.VerificationEvent,
+		EventPayload: payload,
+	})
+	if err := h.emailQueue.PublishBytes(event); err != nil {
+		slog.ErrorContext(ctx, "unable to publish email change verification event", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return
+	}
+
+	h.publishSecurityAlert(ctx, userProfile.Email, model.SecurityAlertPayload{
+		Reason:     model.EmailChangeReason,
+		UserAgent:  c.Request.UserAgent(),
+		IPAddress:  c.ClientIP(),
+		OccurredAt: time.Now().UTC(),
+	})
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "a verification link has been sent to the new email",
+	})
+}
+
+// completeEmailChange changes the email of the user when the verification code belongs to a pending email change.
+// It reports whether the code was handled, registration codes are left to the caller.
+func (h *Handler) completeEmailChange(c *gin.Context, code string) bool {
+	ctx := c.Request.Context()
+	pending, err := h.redisClient.GetDel(ctx, emailChangeKey(code)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch pending email change", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return true
+	}
+
+	var emailChange model.EmailChange
+	if err := json.Unmarshal(pending, &emailChange); err != nil {
+		slog.ErrorContext(ctx, "unable to decode pending email change", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Please try after sometime",
+		})
+		return true
+	}
+
+	if err := h.userService.ChangeEmail(ctx, emailChange.UserID, emailChange.Email); err != nil {
+		if errors.Is(err, domain.ErrEmailTaken) {
+			c.JSON(http.StatusConflict, gin.H{
+				"message": "email is already in use",
+			})
+			return true
+		}
+		h.abortWithUserError(c, err, "unable to change email")
+		return true
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "email changed",
+	})
+	return true
+}
+
+func emailChangeKey(code string) string {
+	return strings.Join([]string{umsConstants.EmailChangeKeyPrefix, code}, ":")
+}
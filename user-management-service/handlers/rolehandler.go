@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"user-management-service/apperror"
+	"user-management-service/domain"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// Roles lists the available roles with their permissions.
+func (h *Handler) Roles(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	roles, err := h.userService.ListRoles(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list roles", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to list roles",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+// LoadUserAccess replaces the roles and permissions of the id token in the user profile with the current ones of the
+// user, so assigned and removed roles apply to this service right away instead of from the next login. Requests of
+// deleted or locked users are rejected even while their access token is still valid.
+func (h *Handler) LoadUserAccess(c *gin.Context) {
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+	ctx := c.Request.Context()
+
+	user, err := h.userService.GetUser(ctx, userProfile.ID.String())
+	switch {
+	case err != nil:
+	case user.DeletedAt != nil:
+		err = sql.ErrNoRows
+	case user.LockedAt != nil:
+		err = domain.ErrUserLocked
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserLocked) {
+			slog.ErrorContext(ctx, "user of request is not available", slog.Any(constants.Error, err))
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"message": "user account is not available",
+			})
+			return
+		}
+		slog.ErrorContext(ctx, "unable to fetch user", slog.Any(constants.Error, err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to authorize request",
+		})
+		return
+	}
+
+	roles, err := h.userService.GetUserRoles(ctx, userProfile.ID.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch roles of user", slog.Any(constants.Error, err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to authorize request",
+		})
+		return
+	}
+	permissions, err := h.userService.GetUserPermissions(ctx, userProfile.ID.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch permissions of user", slog.Any(constants.Error, err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to authorize request",
+		})
+		return
+	}
+
+	userProfile.Roles = roles
+	userProfile.Permissions = permissions
+	c.Set(constants.UserContext, userProfile)
+	c.Next()
+}
+
+// UserRoles lists the roles assigned to a user.
+func (h *Handler) UserRoles(c *gin.Context) {
+	request := model.UserRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	roles, err := h.userService.GetUserRoles(ctx, request.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch roles of user", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to fetch roles",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"roles": roles,
+	})
+}
+
+// AssignRole assigns a role to a user. This service applies it right away, other services from the next login of
+// the user.
+func (h *Handler) AssignRole(c *gin.Context) {
+	request := model.UserRoleRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+
+	if err := h.userService.AssignRole(ctx, request.UserID, request.Role); err != nil {
+		slog.ErrorContext(ctx, "unable to assign role to user", slog.Any(constants.Error, err))
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": "user not found",
+			})
+		case errors.Is(err, domain.ErrRoleNotFound):
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": "role not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": "unable to assign role",
+			})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveRole removes a role from a user.
+func (h *Handler) RemoveRole(c *gin.Context) {
+	request := model.UserRoleRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	if h.isCaller(c, request.UserID) {
+		return
+	}
+	ctx := c.Request.Context()
+
+	if err := h.userService.RemoveRole(ctx, request.UserID, request.Role); err != nil {
+		slog.ErrorContext(ctx, "unable to remove role from user", slog.Any(constants.Error, err))
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": "role assignment not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to remove role",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"user-management-service/apperror"
+	"user-management-service/model"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/models"
+)
+
+// Sessions lists the active sessions of the signed-in user.
+func (h *Handler) Sessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	session := c.MustGet(constants.SessionContext).(models.Session)
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	response, err := h.tmsClient.ListSessions(ctx, &pb.ListSessionsRequest{
+		UserID:             userProfile.ID.String(),
+		CurrentSessionID:   session.ID,
+		CurrentAccessToken: session.AccessToken,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list sessions", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to list sessions",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toSessions(response.Sessions))
+}
+
+func toSessions(sessionInfos []*pb.SessionInfo) []model.Session {
+	sessions := make([]model.Session, 0, len(sessionInfos))
+	for _, s := range sessionInfos {
+		sessions = append(sessions, model.Session{
+			ID:         s.ID,
+			ClientID:   s.ClientID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  time.Unix(s.CreatedAt, 0).UTC(),
+			LastUsedAt: time.Unix(s.LastUsedAt, 0).UTC(),
+			Current:    s.Current,
+		})
+	}
+
+	return sessions
+}
+
+// RevokeSession revokes a single session of the signed-in user.
+func (h *Handler) RevokeSession(c *gin.Context) {
+	request := model.SessionRequest{}
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": apperror.CustomValidationError(err),
+		})
+		return
+	}
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	if _, err := h.tmsClient.RevokeSession(ctx, &pb.RevokeSessionRequest{
+		UserID: userProfile.ID.String(),
+		ID:     request.ID,
+	}); err != nil {
+		slog.ErrorContext(ctx, "unable to revoke session", slog.Any(constants.Error, err))
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": "session not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to revoke session",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeAllSessions signs the user out everywhere by revoking all of their sessions.
+func (h *Handler) RevokeAllSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	userProfile := c.MustGet(constants.UserContext).(models.UserProfile)
+
+	if _, err := h.tmsClient.RevokeAllSessions(ctx, &pb.RevokeAllSessionsRequest{
+		UserID: userProfile.ID.String(),
+	}); err != nil {
+		slog.ErrorContext(ctx, "unable to revoke all sessions", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to revoke sessions",
+		})
+		return
+	}
+
+	clearAuthCookies(c)
+
+	c.Status(http.StatusNoContent)
+}
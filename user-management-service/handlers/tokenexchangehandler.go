@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/constants"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/jwks"
+)
+
+// tokenExchangeKeySetMaxAge is how long resource servers may cache the token exchange key set.
+const tokenExchangeKeySetMaxAge = "public, max-age=3600"
+
+// TokenExchangeKeySet publishes the public keys the access tokens exchanged by the token service are signed with, so
+// resource servers can validate them like the access tokens of the oauth2 server.
+func (h *Handler) TokenExchangeKeySet(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.tmsClient.GetTokenExchangeKeySet(ctx, &pb.EmptyGrpcMessage{})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to fetch token exchange key set", slog.Any(constants.Error, err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "unable to fetch key set",
+		})
+		return
+	}
+
+	keySet := jwks.KeySet{Keys: make([]jwks.JSONWebKey, 0, len(response.Keys))}
+	for _, key := range response.Keys {
+		keySet.Keys = append(keySet.Keys, jwks.JSONWebKey{
+			KeyID:     key.Kid,
+			KeyType:   key.Kty,
+			Use:       key.Use,
+			Algorithm: key.Alg,
+			N:         key.N,
+			E:         key.E,
+			Curve:     key.Crv,
+			X:         key.X,
+			Y:         key.Y,
+		})
+	}
+
+	c.Header("Cache-Control", tokenExchangeKeySetMaxAge)
+	c.JSON(http.StatusOK, keySet)
+}
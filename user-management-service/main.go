@@ -4,13 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	appConfig "user-management-service/config"
 	"user-management-service/domain"
+	"user-management-service/grpcserver"
 	"user-management-service/handlers"
 
 	"github.com/adjust/rmq/v5"
@@ -21,6 +28,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/imharish-sivakumar/modern-oauth2-system/cisauth-proto/pb"
 	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/middlewares/authentication"
+	"github.com/imharish-sivakumar/modern-oauth2-system/service-utils/middlewares/authorization"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -28,7 +36,8 @@ import (
 
 func main() {
 	errChan := make(chan error)
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	serviceConfig, err := appConfig.Load()
 	if err != nil {
@@ -36,6 +45,10 @@ func main() {
 		return
 	}
 	router := gin.Default()
+	if err := router.SetTrustedProxies(serviceConfig.TrustedProxies); err != nil {
+		log.Println("invalid trusted proxies", err)
+		return
+	}
 
 	cfg, err := config.LoadDefaultConfig(ctx)
 	if err != nil {
@@ -105,9 +118,54 @@ func main() {
 
 	tmsClient := pb.NewTokenServiceClient(conn)
 
-	handler := handlers.NewHandler(kmsClient, tmsClient, serviceConfig, redisClient, service, emailQueue)
+	grpcServer := grpcserver.NewGRPCServer(strings.Join([]string{"", strconv.Itoa(serviceConfig.GRPCPort)}, ":"), grpcserver.NewGRPCHandler(service))
+	defer grpcServer.Stop()
+	go func() {
+		log.Println("user service gRPC server has started at port", serviceConfig.GRPCPort)
+		if err := grpcServer.ListenAndServe(); err != nil {
+			log.Println("user service gRPC server stopped", err)
+			stop()
+		}
+	}()
+
+	go domain.NewAccountPurge(serviceConfig.AccountDeletion, service, redisClient).Run(ctx)
+
+	passkeys, err := domain.NewPasskeys(serviceConfig.WebAuthn, service, redisClient)
+	if err != nil {
+		log.Println("invalid webauthn configuration", err)
+		return
+	}
+
+	federation := domain.NewFederation(serviceConfig.Federation, service, redisClient, &http.Client{Timeout: 10 * time.Second})
 
-	tokenMiddleware, err := authentication.NewTokenMiddleware("", tmsClient)
+	passwordHasher, err := domain.NewPasswordHasher(serviceConfig.PasswordHashing)
+	if err != nil {
+		log.Println("invalid password hashing configuration", err)
+		return
+	}
+
+	passwordRules, err := domain.PasswordRules(serviceConfig.PasswordPolicy, service, passwordHasher)
+	if err != nil {
+		log.Println("invalid password policy configuration", err)
+		return
+	}
+
+	handler := handlers.NewHandler(kmsClient, tmsClient, serviceConfig, redisClient, service, passkeys, federation,
+		domain.NewPasswordPolicy(passwordRules...), passwordHasher, emailQueue)
+
+	middlewareOptions := []authentication.Option{authentication.WithTokenServiceClient(tmsClient)}
+	if localValidation := serviceConfig.LocalTokenValidation; localValidation != nil {
+		middlewareOptions = append(middlewareOptions, authentication.WithLocalValidation(authentication.LocalValidationConfig{
+			KeySetURL:      localValidation.KeySetURL,
+			Issuer:         localValidation.Issuer,
+			Audience:       localValidation.Audience,
+			Scopes:         localValidation.Scopes,
+			RefreshWindow:  time.Duration(localValidation.RefreshWindowSeconds) * time.Second,
+			TrustedIssuers: trustedIssuers(localValidation),
+		}))
+	}
+
+	tokenMiddleware, err := authentication.NewTokenMiddlewareWithOptions("", middlewareOptions...)
 	if err != nil {
 		fmt.Println("unable to create token middleware", err)
 		return
@@ -115,18 +173,93 @@ func main() {
 
 	routerGroup := router.Group("/user-service/v1")
 	routerGroup.Handle(http.MethodPost, "/users", handler.Register)
+	routerGroup.Handle(http.MethodGet, "/login", handler.LoginChallenge)
 	routerGroup.Handle(http.MethodPost, "/login", handler.LoginWithPassword)
+	routerGroup.Handle(http.MethodPost, "/login/mfa", handler.LoginWithMFA)
+	routerGroup.Handle(http.MethodPost, "/login/otp", handler.RequestLoginCode)
+	routerGroup.Handle(http.MethodPost, "/login/otp/verify", handler.LoginWithCode)
+	routerGroup.Handle(http.MethodPost, "/login/passkey/begin", handler.BeginPasskeyLogin)
+	routerGroup.Handle(http.MethodPost, "/login/passkey/finish", handler.FinishPasskeyLogin)
+	routerGroup.Handle(http.MethodPost, "/login/federated/callback", handler.FinishFederatedLogin)
+	routerGroup.Handle(http.MethodPost, "/login/federated/:provider", handler.BeginFederatedLogin)
 	routerGroup.Handle(http.MethodGet, "/login/consent", handler.ConsentChallenge)
+	routerGroup.Handle(http.MethodPost, "/login/consent/accept", handler.AcceptConsent)
+	routerGroup.Handle(http.MethodPost, "/login/consent/reject", handler.RejectConsent)
 	routerGroup.Handle(http.MethodGet, "/verify", handler.VerifyEmail)
+	routerGroup.Handle(http.MethodPost, "/password/forgot", handler.ForgotPassword)
+	routerGroup.Handle(http.MethodPost, "/password/reset", handler.ResetPassword)
 	routerGroup.Handle(http.MethodGet, "/login/accept", func(c *gin.Context) {
 		c.AbortWithStatus(http.StatusOK)
 	})
 	routerGroup.Handle(http.MethodPost, "/token/exchange", handler.Exchange)
+	routerGroup.Handle(http.MethodGet, "/token/exchange/jwks.json", handler.TokenExchangeKeySet)
+	routerGroup.Handle(http.MethodPost, "/oauth2/register", handler.RegisterClient)
+	routerGroup.Handle(http.MethodGet, "/oauth2/register/:clientID", handler.RegisteredClient)
+	routerGroup.Handle(http.MethodPut, "/oauth2/register/:clientID", handler.UpdateRegisteredClient)
+	routerGroup.Handle(http.MethodDelete, "/oauth2/register/:clientID", handler.DeleteRegisteredClient)
 	routerGroup.Use(tokenMiddleware.DoAuthenticate)
-	routerGroup.Handle(http.MethodGet, "/user", handler.User)
+	routerGroup.Handle(http.MethodGet, "/user", authorization.RequireScopes("openid"), handler.User)
+	routerGroup.Handle(http.MethodPatch, "/user", authorization.RequireScopes("openid"), handler.UpdateProfile)
+	routerGroup.Handle(http.MethodPost, "/user/password", authorization.RequireScopes("openid"), handler.ChangePassword)
+	routerGroup.Handle(http.MethodPost, "/user/email", authorization.RequireScopes("openid"), handler.ChangeEmail)
+	routerGroup.Handle(http.MethodPost, "/user/reauthentication/code", authorization.RequireScopes("openid"), handler.RequestReauthenticationCode)
+	routerGroup.Handle(http.MethodGet, "/user/export", authorization.RequireScopes("openid"), handler.ExportAccount)
+	routerGroup.Handle(http.MethodDelete, "/user", authorization.RequireScopes("openid"), handler.DeleteAccount)
+	routerGroup.Handle(http.MethodPost, "/logout", handler.Logout)
+	routerGroup.Handle(http.MethodGet, "/sessions", handler.Sessions)
+	routerGroup.Handle(http.MethodDelete, "/sessions", handler.RevokeAllSessions)
+	routerGroup.Handle(http.MethodDelete, "/sessions/:id", handler.RevokeSession)
+	routerGroup.Handle(http.MethodPost, "/mfa/totp", handler.EnrollTOTP)
+	routerGroup.Handle(http.MethodPost, "/mfa/totp/confirm", handler.ConfirmTOTP)
+	routerGroup.Handle(http.MethodDelete, "/mfa/totp", handler.DisableTOTP)
+	routerGroup.Handle(http.MethodGet, "/passkeys", handler.Passkeys)
+	routerGroup.Handle(http.MethodPost, "/passkeys/register/begin", handler.BeginPasskeyRegistration)
+	routerGroup.Handle(http.MethodPost, "/passkeys/register/finish", handler.FinishPasskeyRegistration)
+	routerGroup.Handle(http.MethodDelete, "/passkeys/:id", handler.DeletePasskey)
 
-	if err = router.Run(fmt.Sprintf(":%d", serviceConfig.Port)); err != nil {
-		log.Println(err)
-		return
+	adminGroup := routerGroup.Group("/admin", handler.LoadUserAccess)
+	adminGroup.Handle(http.MethodGet, "/roles", authorization.RequirePermissions(domain.UsersReadPermission), handler.Roles)
+	adminGroup.Handle(http.MethodGet, "/users", authorization.RequirePermissions(domain.UsersReadPermission), handler.ListUsers)
+	adminGroup.Handle(http.MethodGet, "/users/:userID", authorization.RequirePermissions(domain.UsersReadPermission), handler.GetUser)
+	adminGroup.Handle(http.MethodDelete, "/users/:userID", authorization.RequirePermissions(domain.UsersWritePermission), handler.DeleteUser)
+	adminGroup.Handle(http.MethodPost, "/users/:userID/restore", authorization.RequirePermissions(domain.UsersWritePermission), handler.RestoreUser)
+	adminGroup.Handle(http.MethodPost, "/users/:userID/lock", authorization.RequirePermissions(domain.UsersWritePermission), handler.LockUser)
+	adminGroup.Handle(http.MethodPost, "/users/:userID/unlock", authorization.RequirePermissions(domain.UsersWritePermission), handler.UnlockUser)
+	adminGroup.Handle(http.MethodPost, "/users/:userID/password/reset", authorization.RequirePermissions(domain.UsersWritePermission), handler.ForcePasswordReset)
+	adminGroup.Handle(http.MethodGet, "/users/:userID/roles", authorization.RequirePermissions(domain.UsersReadPermission), handler.UserRoles)
+	adminGroup.Handle(http.MethodPut, "/users/:userID/roles/:role", authorization.RequirePermissions(domain.RolesWritePermission), handler.AssignRole)
+	adminGroup.Handle(http.MethodDelete, "/users/:userID/roles/:role", authorization.RequirePermissions(domain.RolesWritePermission), handler.RemoveRole)
+	adminGroup.Handle(http.MethodPost, "/clients", authorization.RequirePermissions(domain.ClientsWritePermission), handler.CreateClient)
+	adminGroup.Handle(http.MethodPut, "/clients/:clientID", authorization.RequirePermissions(domain.ClientsWritePermission), handler.UpdateClient)
+	adminGroup.Handle(http.MethodPost, "/clients/:clientID/secret", authorization.RequirePermissions(domain.ClientsWritePermission), handler.RotateClientSecret)
+	adminGroup.Handle(http.MethodDelete, "/clients/:clientID", authorization.RequirePermissions(domain.ClientsWritePermission), handler.DeleteClient)
+	adminGroup.Handle(http.MethodPost, "/clients/initial-access-tokens", authorization.RequirePermissions(domain.ClientsWritePermission), handler.IssueInitialAccessToken)
+
+	server := &http.Server{Addr: fmt.Sprintf(":%d", serviceConfig.Port), Handler: router}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println(err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	log.Println("shutting down user service")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Println("unable to shut down http server", err)
+	}
+}
+
+// trustedIssuers accepts the access tokens exchanged by the token service when their issuer is configured.
+func trustedIssuers(localValidation *appConfig.LocalTokenValidation) []authentication.TrustedIssuer {
+	if len(localValidation.TokenExchangeIssuer) == 0 {
+		return nil
 	}
+
+	return []authentication.TrustedIssuer{{
+		Issuer:    localValidation.TokenExchangeIssuer,
+		KeySetURL: localValidation.TokenExchangeKeySetURL,
+	}}
 }
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
 
 type User struct {
 	ID              string     `json:"ID"`
@@ -11,6 +15,9 @@ type User struct {
 	CreatedAt       time.Time  `json:"createdAt"`
 	UpdatedAt       *time.Time `json:"updatedAt"`
 	DeletedAt       *time.Time `json:"deletedAt"`
+	LockedAt        *time.Time `json:"lockedAt"`
+	// PasswordResetRequired blocks login until the user resets their password.
+	PasswordResetRequired bool `json:"passwordResetRequired"`
 }
 
 type VerifyEmail struct {
@@ -20,7 +27,17 @@ type VerifyEmail struct {
 type EventType string
 
 const (
-	VerificationEvent EventType = "VerificationEvent"
+	VerificationEvent  EventType = "VerificationEvent"
+	ResetPasswordEvent EventType = "ResetPasswordEvent"
+	LoginCodeEvent     EventType = "LoginCodeEvent"
+	SecurityAlertEvent EventType = "SecurityAlertEvent"
+)
+
+const (
+	// AccountLockoutReason is the security alert reason when an account is locked after repeated failed logins.
+	AccountLockoutReason = "account_lockout"
+	// EmailChangeReason is the security alert reason sent to the previous email when a user changes their email.
+	EmailChangeReason = "email_change"
 )
 
 type Event struct {
@@ -30,10 +47,135 @@ type Event struct {
 }
 
 // Login is a user login request model with email and password.
+// Remember keeps the user signed in with the oauth2 server, later logins of the client are accepted without credentials.
 type Login struct {
 	Email          string `json:"email" binding:"required,email,min=5,max=50"`
 	Password       string `json:"password" binding:"required"`
 	LoginChallenge string `json:"loginChallenge" binding:"required,loginChallenge"`
+	Remember       bool   `json:"remember"`
+}
+
+// LoginRequest is a request model for the login screen of a login challenge.
+type LoginRequest struct {
+	LoginChallenge string `form:"login_challenge" binding:"required,loginChallenge"`
+}
+
+// LoginPrompt is a login screen response model, returned when the user has to enter their credentials.
+// LoginHint is the email the client suggested to pre-fill the login form with.
+type LoginPrompt struct {
+	LoginChallenge string       `json:"loginChallenge"`
+	Client         OAuth2Client `json:"client"`
+	RequestedScope []string     `json:"requestedScope"`
+	LoginHint      string       `json:"loginHint"`
+}
+
+// LoginCodeRequest is a request model sending a one-time login code to the email of the user.
+type LoginCodeRequest struct {
+	Email          string `json:"email" binding:"required,email,min=5,max=50"`
+	LoginChallenge string `json:"loginChallenge" binding:"required,loginChallenge"`
+}
+
+// LoginCode is a request model completing a passwordless login with the code sent by email.
+type LoginCode struct {
+	Email          string `json:"email" binding:"required,email,min=5,max=50"`
+	LoginChallenge string `json:"loginChallenge" binding:"required,loginChallenge"`
+	Code           string `json:"code" binding:"required,min=6,max=20"`
+	Remember       bool   `json:"remember"`
+}
+
+// LoginCodePayload is the payload of LoginCodeEvent.
+type LoginCodePayload struct {
+	Code             string `json:"code"`
+	ExpiresInMinutes int    `json:"expiresInMinutes"`
+}
+
+// SecurityAlertPayload is the payload of SecurityAlertEvent.
+type SecurityAlertPayload struct {
+	Reason     string    `json:"reason"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// ForgotPassword is a forgot password request model.
+type ForgotPassword struct {
+	Email string `json:"email" binding:"required,email,min=5,max=50"`
+}
+
+// ResetPassword is a reset password request model with KMS encrypted passwords.
+type ResetPassword struct {
+	Token           string `json:"token" binding:"required"`
+	NewPassword     string `json:"newPassword" binding:"required"`
+	ConfirmPassword string `json:"confirmPassword" binding:"required"`
+}
+
+// UpdateProfile is a request model updating the profile of the signed-in user.
+type UpdateProfile struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// ChangePassword is a change password request model with KMS encrypted passwords.
+type ChangePassword struct {
+	OldPassword     string `json:"oldPassword" binding:"required"`
+	NewPassword     string `json:"newPassword" binding:"required"`
+	ConfirmPassword string `json:"confirmPassword" binding:"required"`
+}
+
+// ChangeEmail is a request model changing the email of the signed-in user, the new email has to be verified.
+// The KMS encrypted password or a code sent to the current email confirms the change.
+type ChangeEmail struct {
+	Email    string `json:"email" binding:"required,email,min=5,max=50"`
+	Password string `json:"password" binding:"required_without=Code"`
+	Code     string `json:"code" binding:"required_without=Password,max=20"`
+}
+
+// DeleteAccount is a request model deleting the signed-in user, the KMS encrypted password or a code sent to their
+// email confirms the deletion. Users without a password, like users provisioned by federated login, confirm with a code.
+type DeleteAccount struct {
+	Password string `json:"password" binding:"required_without=Code"`
+	Code     string `json:"code" binding:"required_without=Password,max=20"`
+}
+
+// AccountExport is the export of the personal data held about the signed-in user.
+type AccountExport struct {
+	Profile    AccountProfile   `json:"profile"`
+	Sessions   []Session        `json:"sessions"`
+	Identities []LinkedIdentity `json:"identities"`
+	Consents   []ConsentGrant   `json:"consents"`
+	ExportedAt time.Time        `json:"exportedAt"`
+}
+
+// AccountProfile is the profile of a user in the account export.
+type AccountProfile struct {
+	ID        string     `json:"id"`
+	Email     string     `json:"email"`
+	Name      string     `json:"name"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// LinkedIdentity is an upstream identity linked to a user in the account export.
+type LinkedIdentity struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"`
+	Email    string    `json:"email"`
+	LinkedAt time.Time `json:"linkedAt"`
+}
+
+// ConsentGrant is a consent the user granted to an oauth2 client.
+type ConsentGrant struct {
+	ClientID   string    `json:"clientID"`
+	ClientName string    `json:"clientName"`
+	Scopes     []string  `json:"scopes"`
+	Audience   []string  `json:"audience"`
+	GrantedAt  time.Time `json:"grantedAt"`
+	Remember   bool      `json:"remember"`
+}
+
+// EmailChange is a pending email change waiting for the verification of the new email.
+type EmailChange struct {
+	UserID string `json:"userID"`
+	Email  string `json:"email"`
 }
 
 // ConsentRequest is a user login consent request model.
@@ -41,17 +183,121 @@ type ConsentRequest struct {
 	ConsentChallenge string `form:"consent_challenge" binding:"required"`
 }
 
+// ConsentPrompt is a consent screen response model with the client and the scope and audience it requests.
+type ConsentPrompt struct {
+	ConsentChallenge  string       `json:"consentChallenge"`
+	Client            OAuth2Client `json:"client"`
+	RequestedScope    []string     `json:"requestedScope"`
+	RequestedAudience []string     `json:"requestedAudience"`
+}
+
+// OAuth2Client is the oauth2 client asking for login or consent.
+type OAuth2Client struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	LogoURI string `json:"logoURI"`
+}
+
+// ConsentDecision is a request model granting a client all or part of the requested scope and audience.
+// Remember skips the consent screen for the client for as long as the client is configured to remember consents.
+type ConsentDecision struct {
+	ConsentChallenge string   `json:"consentChallenge" binding:"required"`
+	GrantScope       []string `json:"grantScope"`
+	GrantAudience    []string `json:"grantAudience"`
+	Remember         bool     `json:"remember"`
+}
+
+// ConsentRejection is a request model denying a client access.
+type ConsentRejection struct {
+	ConsentChallenge string `json:"consentChallenge" binding:"required"`
+}
+
+// ClientRequest is a request model for a single oauth2 client.
+type ClientRequest struct {
+	ClientID string `uri:"clientID" binding:"required"`
+}
+
+// ClientMetadata is a request model for the metadata of an oauth2 client managed by an admin.
+// Trusted clients skip the consent screen, the remember durations of zero turn remember-me off for the client.
+type ClientMetadata struct {
+	ClientName                string   `json:"clientName" binding:"required,max=100"`
+	RedirectURIs              []string `json:"redirectURIs"`
+	GrantTypes                []string `json:"grantTypes" binding:"required"`
+	ResponseTypes             []string `json:"responseTypes"`
+	Scope                     []string `json:"scope"`
+	Audience                  []string `json:"audience"`
+	TokenEndpointAuthMethod   string   `json:"tokenEndpointAuthMethod" binding:"required,oneof=client_secret_basic client_secret_post none"`
+	LogoURI                   string   `json:"logoURI" binding:"omitempty,url"`
+	Trusted                   bool     `json:"trusted"`
+	LoginRememberForSeconds   int32    `json:"loginRememberForSeconds" binding:"gte=0"`
+	ConsentRememberForSeconds int32    `json:"consentRememberForSeconds" binding:"gte=0"`
+}
+
+// Client is an oauth2 client response model, the secret is only set when it was generated.
+type Client struct {
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	ClientMetadata
+}
+
+// InitialAccessTokenRequest is a request model issuing an initial access token for dynamic client registration.
+type InitialAccessTokenRequest struct {
+	ExpiresInSeconds int32 `json:"expiresInSeconds" binding:"gte=0"`
+}
+
+// InitialAccessToken is an initial access token response model, the token registers a single client.
+type InitialAccessToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ClientRegistrationMetadata is the client metadata of a dynamic client registration request (RFC 7591).
+type ClientRegistrationMetadata struct {
+	RedirectURIs            []string `json:"redirect_uris"`
+	GrantTypes              []string `json:"grant_types,omitempty"`
+	ResponseTypes           []string `json:"response_types,omitempty"`
+	ClientName              string   `json:"client_name,omitempty"`
+	Scope                   string   `json:"scope,omitempty"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method,omitempty"`
+	LogoURI                 string   `json:"logo_uri,omitempty"`
+}
+
+// ClientRegistrationResponse is a client information response model of dynamic client registration (RFC 7591 and 7592).
+// ClientSecretExpiresAt is always zero as client secrets do not expire.
+type ClientRegistrationResponse struct {
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64  `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   int64  `json:"client_secret_expires_at"`
+	RegistrationAccessToken string `json:"registration_access_token"`
+	RegistrationClientURI   string `json:"registration_client_uri"`
+	ClientRegistrationMetadata
+}
+
+// RegisteredClientRequest is a request model for a client registered through dynamic client registration.
+type RegisteredClientRequest struct {
+	ClientID string `uri:"clientID" binding:"required"`
+}
+
 // AcceptLogin is a user login accept request model.
 type AcceptLogin struct {
 	RedirectTo string `json:"redirect_to"`
 }
 
+// Logout is a user logout request model.
+// RevokeLoginSessions also revokes the oauth2 server login and consent sessions of the user,
+// so the next login prompts for credentials again.
+type Logout struct {
+	RevokeLoginSessions bool `json:"revokeLoginSessions"`
+}
+
 // TokenExchangeRequest is a token exchange request model.
 type TokenExchangeRequest struct {
 	Code         string `json:"code" binding:"required"`
 	RedirectURI  string `json:"redirectURI" binding:"required"`
 	ClientID     string `json:"clientID" binding:"required"`
 	CodeVerifier string `json:"codeVerifier" binding:"required"`
+	Nonce        string `json:"nonce"`
 }
 
 // TokenExchangeResponse is a token exchange response model.
@@ -63,3 +309,161 @@ type TokenExchangeResponse struct {
 	ExpiresAt    string `json:"expiresAt,omitempty"`
 	SessionID    string `json:"sessionID"`
 }
+
+// Session is a user session response model.
+type Session struct {
+	ID         string    `json:"id"`
+	ClientID   string    `json:"clientID"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	Current    bool      `json:"current"`
+}
+
+// Role is a role response model with the permissions granted by the role.
+type Role struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// UserRequest is a request model addressing a single user.
+type UserRequest struct {
+	UserID string `uri:"userID" binding:"required,uuid"`
+}
+
+// UserRoleRequest is a request model addressing a single role of a user.
+type UserRoleRequest struct {
+	UserID string `uri:"userID" binding:"required,uuid"`
+	Role   string `uri:"role" binding:"required,max=50"`
+}
+
+// UserFilter is an admin user listing request model.
+type UserFilter struct {
+	Search         string `form:"search" binding:"max=100"`
+	Page           int    `form:"page,default=1" binding:"min=1"`
+	PageSize       int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	IncludeDeleted bool   `form:"includeDeleted"`
+}
+
+// AdminUser is a user response model for administrators.
+type AdminUser struct {
+	ID                    string     `json:"id"`
+	Email                 string     `json:"email"`
+	Name                  string     `json:"name"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	UpdatedAt             *time.Time `json:"updatedAt"`
+	DeletedAt             *time.Time `json:"deletedAt"`
+	LockedAt              *time.Time `json:"lockedAt"`
+	PasswordResetRequired bool       `json:"passwordResetRequired"`
+}
+
+// UserPage is a page of the admin user listing.
+type UserPage struct {
+	Users    []AdminUser `json:"users"`
+	Page     int         `json:"page"`
+	PageSize int         `json:"pageSize"`
+	Total    int         `json:"total"`
+}
+
+// TOTP is the time-based one-time password enrollment of a user.
+type TOTP struct {
+	UserID          string
+	EncryptedSecret string
+	Enabled         bool
+	ConfirmedAt     *time.Time
+}
+
+// TOTPEnrollment is a totp enrollment response model, the provisioning uri is rendered as a QR code.
+type TOTPEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningURI"`
+}
+
+// MFACode is a request model carrying a totp or recovery code.
+type MFACode struct {
+	Code string `json:"code" binding:"required,min=6,max=20"`
+}
+
+// RecoveryCodes is a response model with the one-time recovery codes of a user, they are only shown once.
+type RecoveryCodes struct {
+	Codes []string `json:"recoveryCodes"`
+}
+
+// MFARequired is the login response when a second factor has to be verified to complete the login.
+type MFARequired struct {
+	MFARequired bool   `json:"mfaRequired"`
+	MFAToken    string `json:"mfaToken"`
+}
+
+// LoginMFA is a request model verifying the second factor of a pending login.
+type LoginMFA struct {
+	MFAToken string `json:"mfaToken" binding:"required"`
+	Code     string `json:"code" binding:"required,min=6,max=20"`
+}
+
+// Passkey is a WebAuthn credential registered by a user.
+type Passkey struct {
+	ID         []byte
+	UserID     string
+	Name       string
+	Credential webauthn.Credential
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+// PasskeyResponse is a registered passkey response model.
+type PasskeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// PasskeyRegistration is a request model naming the passkey being registered.
+type PasskeyRegistration struct {
+	Name string `form:"name" binding:"max=100"`
+}
+
+// PasskeyLogin is a request model starting a passkey login for an oauth2 login challenge.
+type PasskeyLogin struct {
+	LoginChallenge string `json:"loginChallenge" binding:"required,loginChallenge"`
+	Remember       bool   `json:"remember"`
+}
+
+// Identity links the subject of an upstream identity provider to a user.
+type Identity struct {
+	Provider  string
+	Subject   string
+	UserID    string
+	Email     string
+	CreatedAt time.Time
+}
+
+// FederatedLoginRequest is a request model starting a login with an upstream identity provider.
+type FederatedLoginRequest struct {
+	Provider string `uri:"provider" binding:"required"`
+}
+
+// FederatedLogin is a request model with the oauth2 login challenge of a federated login.
+type FederatedLogin struct {
+	LoginChallenge string `json:"loginChallenge" binding:"required,loginChallenge"`
+	Remember       bool   `json:"remember"`
+}
+
+// FederatedCallback is a request model completing a federated login with the upstream authorization response.
+type FederatedCallback struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+// PasskeyRequest is a single passkey request model, the id is the base64url encoded credential id.
+type PasskeyRequest struct {
+	ID string `uri:"id" binding:"required,base64rawurl"`
+}
+
+// SessionRequest is a single user session request model.
+type SessionRequest struct {
+	ID string `uri:"id" binding:"required"`
+}